@@ -1,6 +1,6 @@
 module github.com/bitrise-io/xcode-project
 
-go 1.15
+go 1.16
 
 require (
 	github.com/bitrise-io/go-plist v0.0.0-20210301100253-4b1a112ccd10
@@ -0,0 +1,29 @@
+package xcodebuild
+
+// genericIOSDestination is the destination used for archiving, it builds for any iOS device.
+const genericIOSDestination = "generic/platform=iOS"
+
+// ArchiveArgs returns the xcodebuild arguments for archiving the scheme of the workspace or project.
+// The workspace takes precedence if both the workspace and the project is given.
+// The configuration is optional, if empty the scheme's archive configuration is used.
+// The additional options are appended before the archive action.
+func ArchiveArgs(project, workspace, scheme, configuration, archivePath string, opts ...string) []string {
+	var args []string
+	if workspace != "" {
+		args = append(args, "-workspace", workspace)
+	} else if project != "" {
+		args = append(args, "-project", project)
+	}
+
+	args = append(args, "-scheme", scheme)
+	if configuration != "" {
+		args = append(args, "-configuration", configuration)
+	}
+
+	args = append(args, "-archivePath", archivePath)
+	args = append(args, "-destination", genericIOSDestination)
+	args = append(args, opts...)
+	args = append(args, "archive")
+
+	return args
+}
@@ -0,0 +1,45 @@
+package xcodebuild
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestArchiveArgs(t *testing.T) {
+	tests := []struct {
+		name          string
+		project       string
+		workspace     string
+		configuration string
+		opts          []string
+		want          []string
+	}{
+		{
+			name:          "project",
+			project:       "ios-simple-objc.xcodeproj",
+			configuration: "Release",
+			want:          []string{"-project", "ios-simple-objc.xcodeproj", "-scheme", "ios-simple-objc", "-configuration", "Release", "-archivePath", "/tmp/ios-simple-objc.xcarchive", "-destination", "generic/platform=iOS", "archive"},
+		},
+		{
+			name:      "workspace without configuration",
+			workspace: "ios-simple-objc.xcworkspace",
+			want:      []string{"-workspace", "ios-simple-objc.xcworkspace", "-scheme", "ios-simple-objc", "-archivePath", "/tmp/ios-simple-objc.xcarchive", "-destination", "generic/platform=iOS", "archive"},
+		},
+		{
+			name:          "workspace takes precedence over project, with options",
+			project:       "ios-simple-objc.xcodeproj",
+			workspace:     "ios-simple-objc.xcworkspace",
+			configuration: "Release",
+			opts:          []string{"-allowProvisioningUpdates", "COMPILER_INDEX_STORE_ENABLE=NO"},
+			want:          []string{"-workspace", "ios-simple-objc.xcworkspace", "-scheme", "ios-simple-objc", "-configuration", "Release", "-archivePath", "/tmp/ios-simple-objc.xcarchive", "-destination", "generic/platform=iOS", "-allowProvisioningUpdates", "COMPILER_INDEX_STORE_ENABLE=NO", "archive"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ArchiveArgs(tt.project, tt.workspace, "ios-simple-objc", tt.configuration, "/tmp/ios-simple-objc.xcarchive", tt.opts...)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ArchiveArgs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
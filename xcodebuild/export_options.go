@@ -0,0 +1,29 @@
+package xcodebuild
+
+import (
+	"io/ioutil"
+
+	"github.com/bitrise-io/go-plist"
+)
+
+// ExportOptions represents the content of the ExportOptions.plist used by xcodebuild -exportArchive.
+type ExportOptions struct {
+	// Method is the distribution method, like app-store, ad-hoc, enterprise or development.
+	Method string `plist:"method,omitempty"`
+	// TeamID is the Developer Portal team to use for the export.
+	TeamID string `plist:"teamID,omitempty"`
+	// SigningStyle is either manual or automatic.
+	SigningStyle string `plist:"signingStyle,omitempty"`
+	// ProvisioningProfiles maps bundle ids to provisioning profile names or UUIDs, used for manual signing.
+	ProvisioningProfiles map[string]string `plist:"provisioningProfiles,omitempty"`
+}
+
+// WriteExportOptionsPlist writes the export options into an XML plist at pth.
+func WriteExportOptionsPlist(opts ExportOptions, pth string) error {
+	content, err := plist.MarshalIndent(opts, plist.XMLFormat, "\t")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(pth, content, 0644)
+}
@@ -0,0 +1,33 @@
+package xcodebuild
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/bitrise-io/go-utils/pathutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteExportOptionsPlist(t *testing.T) {
+	tmpDir, err := pathutil.NormalizedOSTempDirPath("__xcode-proj__")
+	require.NoError(t, err)
+	pth := filepath.Join(tmpDir, "ExportOptions.plist")
+
+	opts := ExportOptions{
+		Method:       "app-store",
+		TeamID:       "ABCD1234",
+		SigningStyle: "manual",
+		ProvisioningProfiles: map[string]string{
+			"io.bitrise.sample":                 "Sample App Store",
+			"io.bitrise.sample.share-extension": "3f2d8a0e-5d2b-4bd2-9d84-1c3e4b5f6a7b",
+		},
+	}
+	require.NoError(t, WriteExportOptionsPlist(opts, pth))
+
+	content, err := ioutil.ReadFile(pth)
+	require.NoError(t, err)
+	expected, err := ioutil.ReadFile(filepath.Join("testdata", "ExportOptions.plist"))
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(content))
+}
@@ -0,0 +1,37 @@
+package xcodeproj
+
+import (
+	"strings"
+)
+
+// TargetArchs returns the target's ARCHS build setting which applies when building for the given sdk (like iphonesimulator17.0),
+// considering the sdk conditional variants (like ARCHS[sdk=iphonesimulator*]).
+// The values are returned unresolved, like $(ARCHS_STANDARD).
+func (p XcodeProj) TargetArchs(target, configuration, sdk string) ([]string, error) {
+	return p.targetConditionalBuildSettingList(target, configuration, "ARCHS", map[string]string{"sdk": sdk})
+}
+
+// TargetExcludedArchs returns the target's EXCLUDED_ARCHS build setting which applies when building for the given sdk,
+// considering the sdk conditional variants (like EXCLUDED_ARCHS[sdk=iphonesimulator*]).
+func (p XcodeProj) TargetExcludedArchs(target, configuration, sdk string) ([]string, error) {
+	return p.targetConditionalBuildSettingList(target, configuration, "EXCLUDED_ARCHS", map[string]string{"sdk": sdk})
+}
+
+// TargetValidArchs returns the target's VALID_ARCHS build setting which applies when building for the given sdk,
+// considering the sdk conditional variants (like VALID_ARCHS[sdk=iphonesimulator*]).
+func (p XcodeProj) TargetValidArchs(target, configuration, sdk string) ([]string, error) {
+	return p.targetConditionalBuildSettingList(target, configuration, "VALID_ARCHS", map[string]string{"sdk": sdk})
+}
+
+// SetTargetExcludedArchs sets the target's EXCLUDED_ARCHS build setting in the given configuration,
+// or in all of the target's configurations if the configuration is empty.
+// If the sdk pattern is not empty, the sdk conditional variant is set,
+// like EXCLUDED_ARCHS[sdk=iphonesimulator*] = arm64 for the Apple Silicon simulator workaround.
+// The project needs to be saved to persist the change.
+func (p XcodeProj) SetTargetExcludedArchs(target, configuration, sdk string, archs []string) error {
+	conditions := map[string]string{}
+	if sdk != "" {
+		conditions["sdk"] = sdk
+	}
+	return p.SetConditionalBuildSetting(target, configuration, "EXCLUDED_ARCHS", conditions, strings.Join(archs, " "))
+}
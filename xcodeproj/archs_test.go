@@ -0,0 +1,102 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_TargetArchs(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithArchs))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name              string
+		sdk               string
+		wantArchs         []string
+		wantExcludedArchs []string
+		wantValidArchs    []string
+	}{
+		{
+			name:           "device",
+			sdk:            "iphoneos17.0",
+			wantArchs:      []string{"$(ARCHS_STANDARD)"},
+			wantValidArchs: []string{"arm64", "arm64e"},
+		},
+		{
+			name:              "simulator",
+			sdk:               "iphonesimulator17.0",
+			wantArchs:         []string{"$(ARCHS_STANDARD)"},
+			wantExcludedArchs: []string{"arm64"},
+			wantValidArchs:    []string{"arm64", "arm64e"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			archs, err := project.TargetArchs("App", "Debug", tt.sdk)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantArchs, archs)
+
+			excludedArchs, err := project.TargetExcludedArchs("App", "Debug", tt.sdk)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantExcludedArchs, excludedArchs)
+
+			validArchs, err := project.TargetValidArchs("App", "Debug", tt.sdk)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantValidArchs, validArchs)
+		})
+	}
+
+	_, err = project.TargetArchs("App", "", "iphoneos")
+	require.Error(t, err)
+}
+
+func TestXcodeProj_SetTargetExcludedArchs(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithBuildFiles, nil)
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+
+	require.NoError(t, project.SetTargetExcludedArchs("App", "", "iphonesimulator*", []string{"arm64"}))
+	require.NoError(t, project.SetTargetExcludedArchs("App", "Release", "", []string{"i386", "armv7"}))
+	require.Error(t, project.SetTargetExcludedArchs("Missing", "", "", nil))
+	require.NoError(t, project.Save())
+
+	project, err = Open(projectPth)
+	require.NoError(t, err)
+
+	excludedArchs, err := project.TargetExcludedArchs("App", "Debug", "iphonesimulator17.0")
+	require.NoError(t, err)
+	require.Equal(t, []string{"arm64"}, excludedArchs)
+
+	excludedArchs, err = project.TargetExcludedArchs("App", "Debug", "iphoneos17.0")
+	require.NoError(t, err)
+	require.Nil(t, excludedArchs)
+
+	excludedArchs, err = project.TargetExcludedArchs("App", "Release", "iphoneos17.0")
+	require.NoError(t, err)
+	require.Equal(t, []string{"i386", "armv7"}, excludedArchs)
+}
+
+var pbxprojWithArchs = newFixtureReplacer(
+	`				ONLY_ACTIVE_ARCH = YES;
+`, `				ARCHS = "$(ARCHS_STANDARD)";
+				ONLY_ACTIVE_ARCH = YES;
+				VALID_ARCHS = "arm64 armv7";
+`,
+	`				INFOPLIST_FILE = App/Info.plist;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.App;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Debug;`, `				"EXCLUDED_ARCHS[sdk=iphonesimulator*]" = arm64;
+				INFOPLIST_FILE = App/Info.plist;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.App;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				TARGETED_DEVICE_FAMILY = "1,2";
+				VALID_ARCHS = (
+					arm64,
+					arm64e,
+				);
+			};
+			name = Debug;`,
+).Replace(pbxprojWithBuildFiles)
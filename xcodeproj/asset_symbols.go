@@ -0,0 +1,19 @@
+package xcodeproj
+
+// TargetGeneratesAssetSymbols reports whether Xcode generates Swift and Objective-C symbols for the target's asset catalog
+// resources (ASSETCATALOG_COMPILER_GENERATE_ASSET_SYMBOLS), like Color.accent for an AccentColor color set.
+// The build setting was introduced in Xcode 15 and defaults to YES.
+func (p XcodeProj) TargetGeneratesAssetSymbols(target, configuration string) (bool, error) {
+	enabled, isSet, err := p.TargetBoolSetting(target, configuration, "ASSETCATALOG_COMPILER_GENERATE_ASSET_SYMBOLS")
+	if err != nil {
+		return false, err
+	}
+	return enabled || !isSet, nil
+}
+
+// SetTargetGeneratesAssetSymbols sets the target's ASSETCATALOG_COMPILER_GENERATE_ASSET_SYMBOLS build setting
+// in the given configuration, or in all of the target's configurations if the configuration is empty.
+// The project needs to be saved to persist the change.
+func (p XcodeProj) SetTargetGeneratesAssetSymbols(target, configuration string, enabled bool) error {
+	return p.setTargetBuildSetting(target, configuration, "ASSETCATALOG_COMPILER_GENERATE_ASSET_SYMBOLS", boolBuildSettingValue(enabled))
+}
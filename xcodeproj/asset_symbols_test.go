@@ -0,0 +1,62 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_TargetGeneratesAssetSymbols(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithoutAssetSymbols, nil)
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(project))
+
+	tests := []struct {
+		target        string
+		configuration string
+		want          bool
+	}{
+		{target: "App", configuration: "Debug", want: true},
+		{target: "App", configuration: "Release", want: false},
+		{target: "Kit", configuration: "Release", want: true},
+	}
+	for _, tt := range tests {
+		got, err := project.TargetGeneratesAssetSymbols(tt.target, tt.configuration)
+		require.NoError(t, err)
+		require.Equal(t, tt.want, got, tt.target+" "+tt.configuration)
+	}
+
+	require.NoError(t, project.SetTargetGeneratesAssetSymbols("App", "Release", true))
+	require.NoError(t, project.SetTargetGeneratesAssetSymbols("Kit", "", false))
+	require.Error(t, project.SetTargetGeneratesAssetSymbols("Kit", "Missing", false))
+	require.NoError(t, project.Save())
+
+	project, err = Open(projectPth)
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(project))
+
+	generates, err := project.TargetGeneratesAssetSymbols("App", "Release")
+	require.NoError(t, err)
+	require.True(t, generates)
+
+	generates, err = project.TargetGeneratesAssetSymbols("Kit", "Debug")
+	require.NoError(t, err)
+	require.False(t, generates)
+}
+
+// pbxprojWithoutAssetSymbols disables the asset symbol generation in the App target's Release configuration.
+var pbxprojWithoutAssetSymbols = newFixtureReplacer(
+	`				INFOPLIST_FILE = App/Info.plist;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.App;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Release;`, `				ASSETCATALOG_COMPILER_GENERATE_ASSET_SYMBOLS = NO;
+				INFOPLIST_FILE = App/Info.plist;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.App;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Release;`,
+).Replace(pbxprojWithBuildFiles)
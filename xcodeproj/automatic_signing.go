@@ -0,0 +1,102 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// CODE_SIGN_STYLE build setting values
+const (
+	CodeSignStyleAutomatic = "Automatic"
+	CodeSignStyleManual    = "Manual"
+)
+
+// CanAutomaticSign reports whether Xcode can sign the target's configuration automatically:
+// the CODE_SIGN_STYLE is Automatic (the default) and a DEVELOPMENT_TEAM is set, as read from the project file.
+// Target level settings override the project level ones, empty values are ignored.
+// The build settings applying to the device SDK of the SDKROOT (like DEVELOPMENT_TEAM[sdk=iphoneos*]) are considered,
+// falling back to the ProvisioningStyle and DevelopmentTeam of the target's TargetAttributes (written by Xcode 8).
+// If the target can not be signed automatically, the returned reason describes why.
+func (p XcodeProj) CanAutomaticSign(target, configuration string) (bool, string, error) {
+	buildConfigurations, err := p.targetConfigurationLevels(target, configuration)
+	if err != nil {
+		return false, "", err
+	}
+
+	t, ok := p.Proj.TargetByName(target)
+	if !ok {
+		return false, "", fmt.Errorf("target not found: %s", target)
+	}
+
+	sdk := buildConfigurationLevelsString(buildConfigurations, "SDKROOT")
+	if sdk == "" {
+		sdk = "iphoneos"
+	}
+	context := map[string]string{"sdk": sdk}
+
+	codeSignStyle, err := conditionalBuildConfigurationLevelsString(buildConfigurations, "CODE_SIGN_STYLE", context)
+	if err != nil {
+		return false, "", err
+	}
+	if codeSignStyle == "" {
+		if codeSignStyle, err = p.targetAttributeString(t.ID, "ProvisioningStyle"); err != nil {
+			return false, "", err
+		}
+	}
+	if codeSignStyle != "" && !strings.EqualFold(codeSignStyle, CodeSignStyleAutomatic) {
+		return false, fmt.Sprintf("target (%s) uses %s code signing (CODE_SIGN_STYLE) in configuration (%s)", target, codeSignStyle, configuration), nil
+	}
+
+	developmentTeam, err := conditionalBuildConfigurationLevelsString(buildConfigurations, "DEVELOPMENT_TEAM", context)
+	if err != nil {
+		return false, "", err
+	}
+	if developmentTeam == "" {
+		if developmentTeam, err = p.targetAttributeString(t.ID, "DevelopmentTeam"); err != nil {
+			return false, "", err
+		}
+	}
+	if developmentTeam == "" {
+		return false, fmt.Sprintf("no development team (DEVELOPMENT_TEAM) set for target (%s) in configuration (%s), automatic code signing requires one", target, configuration), nil
+	}
+
+	return true, "", nil
+}
+
+// conditionalBuildConfigurationLevelsString returns the first non-empty string value of the named build setting
+// which applies in the given context (see conditionalBuildSettingValue) on the build configuration levels.
+func conditionalBuildConfigurationLevelsString(buildConfigurations []BuildConfiguration, name string, context map[string]string) (string, error) {
+	for _, buildConfiguration := range buildConfigurations {
+		value, found, err := conditionalBuildSettingValue(buildConfiguration.BuildSettings, name, context)
+		if err != nil {
+			return "", err
+		}
+		if s, ok := value.(string); found && ok && s != "" {
+			return s, nil
+		}
+	}
+	return "", nil
+}
+
+// targetAttributeString returns the string attribute of the target's TargetAttributes, or an empty string if not set.
+func (p XcodeProj) targetAttributeString(targetID, key string) (string, error) {
+	targetAttributes, err := p.TargetAttributes()
+	if err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	attributes, err := targetAttributes.Object(targetID)
+	if err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return optionalString(attributes, key)
+}
@@ -0,0 +1,129 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_CanAutomaticSign(t *testing.T) {
+	tests := []struct {
+		name          string
+		pbxproj       string
+		target        string
+		configuration string
+		want          bool
+		wantReason    string
+		wantErr       bool
+	}{
+		{
+			name:          "project level team",
+			pbxproj:       pbxprojWithAutomaticSigning,
+			target:        "App",
+			configuration: "Release",
+			want:          true,
+		},
+		{
+			name:          "missing team",
+			pbxproj:       pbxprojWithAutomaticSigning,
+			target:        "App",
+			configuration: "Debug",
+			wantReason:    "no development team (DEVELOPMENT_TEAM) set for target (App) in configuration (Debug), automatic code signing requires one",
+		},
+		{
+			name:          "manual signing",
+			pbxproj:       pbxprojWithAutomaticSigning,
+			target:        "Kit",
+			configuration: "Release",
+			wantReason:    "target (Kit) uses Manual code signing (CODE_SIGN_STYLE) in configuration (Release)",
+		},
+		{
+			name:          "sdk specific team",
+			pbxproj:       pbxprojWithSDKSpecificTeam,
+			target:        "App",
+			configuration: "Release",
+			want:          true,
+		},
+		{
+			name:          "target attributes team",
+			pbxproj:       pbxprojWithSigningTargetAttributes,
+			target:        "App",
+			configuration: "Debug",
+			want:          true,
+		},
+		{
+			name:          "target attributes manual signing",
+			pbxproj:       pbxprojWithSigningTargetAttributes,
+			target:        "Kit",
+			configuration: "Release",
+			wantReason:    "target (Kit) uses Manual code signing (CODE_SIGN_STYLE) in configuration (Release)",
+		},
+		{
+			name:          "missing configuration",
+			pbxproj:       pbxprojWithAutomaticSigning,
+			target:        "App",
+			configuration: "Staging",
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project, err := parsePBXProjContent([]byte(tt.pbxproj))
+			require.NoError(t, err)
+
+			got, reason, err := project.CanAutomaticSign(tt.target, tt.configuration)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.wantReason, reason)
+		})
+	}
+}
+
+// pbxprojWithAutomaticSigning sets the development team in the project's Release configuration,
+// the App target signs automatically, the Kit target signs manually in its Release configuration.
+var pbxprojWithAutomaticSigning = newFixtureReplacer(
+	`				IPHONEOS_DEPLOYMENT_TARGET = 15.0;
+				SDKROOT = iphoneos;
+`, `				DEVELOPMENT_TEAM = 72SA8V3WYL;
+				IPHONEOS_DEPLOYMENT_TARGET = 15.0;
+				SDKROOT = iphoneos;
+`,
+	`				INFOPLIST_FILE = App/Info.plist;
+`, `				CODE_SIGN_STYLE = Automatic;
+				INFOPLIST_FILE = App/Info.plist;
+`,
+	`				SKIP_INSTALL = YES;
+			};
+			name = Release;`, `				CODE_SIGN_STYLE = Manual;
+				SKIP_INSTALL = YES;
+			};
+			name = Release;`,
+).Replace(pbxprojWithBuildFiles)
+
+// pbxprojWithSDKSpecificTeam sets the development team of pbxprojWithAutomaticSigning only for the iOS device SDK.
+var pbxprojWithSDKSpecificTeam = newFixtureReplacer(
+	`				DEVELOPMENT_TEAM = 72SA8V3WYL;
+`, `				"DEVELOPMENT_TEAM[sdk=iphoneos*]" = 72SA8V3WYL;
+`,
+).Replace(pbxprojWithAutomaticSigning)
+
+// pbxprojWithSigningTargetAttributes sets the signing in the TargetAttributes only, like Xcode 8 did:
+// the App target signs automatically with a development team, the Kit target signs manually.
+var pbxprojWithSigningTargetAttributes = newFixtureReplacer(
+	`				LastUpgradeCheck = 1500;
+`, `				LastUpgradeCheck = 1500;
+				TargetAttributes = {
+					E2B0F0402C8B4A0000A1B2C3 = {
+						DevelopmentTeam = 72SA8V3WYL;
+						ProvisioningStyle = Automatic;
+					};
+					E2B0F0412C8B4A0000A1B2C3 = {
+						ProvisioningStyle = Manual;
+					};
+				};
+`,
+).Replace(pbxprojWithBuildFiles)
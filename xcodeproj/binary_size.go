@@ -0,0 +1,71 @@
+package xcodeproj
+
+import (
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// LLVM_LTO build setting values
+const (
+	LLVMLTODisabled    = "NO"
+	LLVMLTOMonolithic  = "YES"
+	LLVMLTOIncremental = "YES_THIN"
+)
+
+// TargetDeadCodeStripping reports whether dead code stripping (DEAD_CODE_STRIPPING) is enabled for the target.
+// The build setting defaults to YES.
+func (p XcodeProj) TargetDeadCodeStripping(target, configuration string) (bool, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return false, err
+	}
+
+	return deadCodeStripping(buildSettings)
+}
+
+// TargetLLVMLTO returns the target's link-time optimization (LLVM_LTO) build setting: NO, YES (monolithic) or YES_THIN (incremental).
+// The build setting defaults to NO.
+func (p XcodeProj) TargetLLVMLTO(target, configuration string) (string, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return "", err
+	}
+
+	return llvmLTO(buildSettings)
+}
+
+// AuditReleaseDeadCodeStripping checks the release (non debug) configurations of the project's native targets
+// and returns a warning for each of them with dead code stripping disabled.
+func (p XcodeProj) AuditReleaseDeadCodeStripping() ([]Warning, error) {
+	return p.auditReleaseConfigurations(func(target, configuration string, buildSettings serialized.Object) ([]Warning, error) {
+		enabled, err := deadCodeStripping(buildSettings)
+		if err != nil || enabled {
+			return nil, err
+		}
+
+		return []Warning{{
+			Target:        target,
+			Configuration: configuration,
+			BuildSetting:  "DEAD_CODE_STRIPPING",
+			Value:         boolBuildSettingValue(enabled),
+			Message:       "dead code stripping disabled in release configuration",
+		}}, nil
+	})
+}
+
+func deadCodeStripping(buildSettings serialized.Object) (bool, error) {
+	enabled, isSet, err := boolBuildSetting(buildSettings, "DEAD_CODE_STRIPPING")
+	if err != nil {
+		return false, err
+	}
+	return !isSet || enabled, nil
+}
+
+func llvmLTO(buildSettings serialized.Object) (string, error) {
+	value, found, err := resolvedBuildSetting(buildSettings, "LLVM_LTO")
+	if err != nil {
+		return "", err
+	} else if !found || value == "" {
+		return LLVMLTODisabled, nil
+	}
+	return value, nil
+}
@@ -0,0 +1,76 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_deadCodeStripping(t *testing.T) {
+	tests := []struct {
+		name          string
+		buildSettings serialized.Object
+		want          bool
+		wantErr       bool
+	}{
+		{name: "default", want: true},
+		{name: "enabled", buildSettings: serialized.Object{"DEAD_CODE_STRIPPING": "YES"}, want: true},
+		{name: "disabled", buildSettings: serialized.Object{"DEAD_CODE_STRIPPING": "NO"}, want: false},
+		{name: "invalid", buildSettings: serialized.Object{"DEAD_CODE_STRIPPING": "SOMETIMES"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := deadCodeStripping(tt.buildSettings)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_llvmLTO(t *testing.T) {
+	got, err := llvmLTO(nil)
+	require.NoError(t, err)
+	require.Equal(t, LLVMLTODisabled, got)
+
+	got, err = llvmLTO(serialized.Object{"LLVM_LTO": "YES_THIN"})
+	require.NoError(t, err)
+	require.Equal(t, LLVMLTOIncremental, got)
+}
+
+func TestXcodeProj_AuditReleaseDeadCodeStripping(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithoutDeadCodeStripping))
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(*project))
+
+	enabled, err := project.TargetDeadCodeStripping("App", "Release")
+	require.NoError(t, err)
+	require.False(t, enabled)
+
+	lto, err := project.TargetLLVMLTO("App", "Release")
+	require.NoError(t, err)
+	require.Equal(t, LLVMLTOMonolithic, lto)
+
+	warnings, err := project.AuditReleaseDeadCodeStripping()
+	require.NoError(t, err)
+	require.Equal(t, []Warning{{
+		Target:        "App",
+		Configuration: "Release",
+		BuildSetting:  "DEAD_CODE_STRIPPING",
+		Value:         "NO",
+		Message:       "dead code stripping disabled in release configuration",
+	}}, warnings)
+}
+
+// pbxprojWithoutDeadCodeStripping disables dead code stripping and enables LTO in the App target's configurations.
+var pbxprojWithoutDeadCodeStripping = newFixtureReplacer(
+	`				INFOPLIST_FILE = App/Info.plist;
+`, `				DEAD_CODE_STRIPPING = NO;
+				INFOPLIST_FILE = App/Info.plist;
+				LLVM_LTO = YES;
+`,
+).Replace(pbxprojWithBuildFiles)
@@ -0,0 +1,57 @@
+package xcodeproj
+
+import (
+	"path/filepath"
+
+	"github.com/bitrise-io/go-utils/pathutil"
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// BuildDirs are the absolute paths of the target's intermediate and product directories.
+type BuildDirs struct {
+	// ObjRoot is the OBJROOT, the root of the intermediate build files.
+	ObjRoot string
+	// SymRoot is the SYMROOT, the root of the build products.
+	SymRoot string
+	// ConfigurationBuildDir is the CONFIGURATION_BUILD_DIR, the directory of the configuration's build products.
+	ConfigurationBuildDir string
+}
+
+// TargetBuildDirs returns the target's resolved OBJROOT, SYMROOT and CONFIGURATION_BUILD_DIR build settings.
+// Relative paths are resolved against the project's directory, missing build settings are returned as empty paths.
+// The directories are on disk, so projects opened by OpenFS return an error.
+func (p XcodeProj) TargetBuildDirs(target, configuration string) (BuildDirs, error) {
+	if err := p.checkOnDisk(); err != nil {
+		return BuildDirs{}, err
+	}
+
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return BuildDirs{}, err
+	}
+
+	return buildDirs(buildSettings, filepath.Dir(p.Path))
+}
+
+func buildDirs(buildSettings serialized.Object, projectDir string) (BuildDirs, error) {
+	var dirs BuildDirs
+	for key, dir := range map[string]*string{
+		"OBJROOT":                 &dirs.ObjRoot,
+		"SYMROOT":                 &dirs.SymRoot,
+		"CONFIGURATION_BUILD_DIR": &dirs.ConfigurationBuildDir,
+	} {
+		pth, found, err := resolvedBuildSetting(buildSettings, key)
+		if err != nil {
+			return BuildDirs{}, err
+		} else if !found || pth == "" {
+			continue
+		}
+
+		if pathutil.IsRelativePath(pth) {
+			pth = filepath.Join(projectDir, pth)
+		}
+		*dir = pth
+	}
+
+	return dirs, nil
+}
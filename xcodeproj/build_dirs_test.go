@@ -0,0 +1,56 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_buildDirs(t *testing.T) {
+	tests := []struct {
+		name          string
+		buildSettings serialized.Object
+		want          BuildDirs
+	}{
+		{
+			name: "DerivedData",
+			buildSettings: serialized.Object{
+				"OBJROOT":                 "/DerivedData/App/Build/Intermediates.noindex",
+				"SYMROOT":                 "/DerivedData/App/Build/Products",
+				"CONFIGURATION_BUILD_DIR": "/DerivedData/App/Build/Products/Debug-iphoneos",
+			},
+			want: BuildDirs{
+				ObjRoot:               "/DerivedData/App/Build/Intermediates.noindex",
+				SymRoot:               "/DerivedData/App/Build/Products",
+				ConfigurationBuildDir: "/DerivedData/App/Build/Products/Debug-iphoneos",
+			},
+		},
+		{
+			name: "legacy build location relative to the project",
+			buildSettings: serialized.Object{
+				"SRCROOT":                 "/project",
+				"SYMROOT":                 "build",
+				"OBJROOT":                 "$(SYMROOT)",
+				"CONFIGURATION":           "Release",
+				"EFFECTIVE_PLATFORM_NAME": "-iphoneos",
+				"CONFIGURATION_BUILD_DIR": "$(SRCROOT)/$(SYMROOT)/$(CONFIGURATION)$(EFFECTIVE_PLATFORM_NAME)",
+			},
+			want: BuildDirs{
+				ObjRoot:               "/project/build",
+				SymRoot:               "/project/build",
+				ConfigurationBuildDir: "/project/build/Release-iphoneos",
+			},
+		},
+		{
+			name: "missing build settings",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildDirs(tt.buildSettings, "/project")
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
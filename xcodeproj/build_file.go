@@ -0,0 +1,160 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// BuildFileInfo is the flattened view of a PBXBuildFile element of a target's build phase.
+type BuildFileInfo struct {
+	ID     string
+	Target string
+
+	PhaseID   string
+	PhaseType string
+	PhaseName string
+
+	// FileRef is the id of the referenced file, empty for Swift package products (ProductRef).
+	FileRef    string
+	ProductRef string
+	// Path is the path (or the name if the path is not set) of the referenced file element.
+	Path string
+
+	Settings      serialized.Object
+	CompilerFlags string
+	Attributes    []string
+}
+
+// BuildFiles returns the build files of the target's build phases, in the order of the build phases.
+func (p XcodeProj) BuildFiles(targetName string) ([]BuildFileInfo, error) {
+	target, ok := p.Proj.TargetByName(targetName)
+	if !ok {
+		return nil, fmt.Errorf("target not found: %s", targetName)
+	}
+
+	objects, err := p.RawProj.Object("objects")
+	if err != nil {
+		return nil, err
+	}
+
+	var buildFiles []BuildFileInfo
+	for _, phaseID := range target.buildPhaseIDs {
+		phase, err := objects.Object(phaseID)
+		if err != nil {
+			return nil, err
+		}
+
+		phaseType, err := phase.String("isa")
+		if err != nil {
+			return nil, err
+		}
+
+		phaseName, err := phase.String("name")
+		if err != nil {
+			if !serialized.IsKeyNotFoundError(err) {
+				return nil, err
+			}
+			phaseName = defaultBuildPhaseName(phaseType)
+		}
+
+		fileIDs, err := phase.StringSlice("files")
+		if err != nil {
+			if serialized.IsKeyNotFoundError(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		for _, fileID := range fileIDs {
+			buildFile, err := parseBuildFileInfo(fileID, objects)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse build file (%s) of build phase (%s): %s", fileID, phaseID, err)
+			}
+
+			buildFile.Target = target.Name
+			buildFile.PhaseID = phaseID
+			buildFile.PhaseType = phaseType
+			buildFile.PhaseName = phaseName
+
+			buildFiles = append(buildFiles, buildFile)
+		}
+	}
+
+	return buildFiles, nil
+}
+
+// defaultBuildPhaseName returns the name Xcode displays for a build phase without a custom name,
+// like Sources for PBXSourcesBuildPhase.
+func defaultBuildPhaseName(phaseType string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(phaseType, "PBX"), "BuildPhase")
+}
+
+func parseBuildFileInfo(id string, objects serialized.Object) (BuildFileInfo, error) {
+	rawBuildFile, err := objects.Object(id)
+	if err != nil {
+		return BuildFileInfo{}, err
+	}
+
+	if isa, err := rawBuildFile.String("isa"); err != nil {
+		return BuildFileInfo{}, err
+	} else if isa != "PBXBuildFile" {
+		return BuildFileInfo{}, fmt.Errorf("not a PBXBuildFile element")
+	}
+
+	buildFile := BuildFileInfo{ID: id}
+
+	if buildFile.FileRef, err = optionalString(rawBuildFile, "fileRef"); err != nil {
+		return BuildFileInfo{}, err
+	}
+	if buildFile.ProductRef, err = optionalString(rawBuildFile, "productRef"); err != nil {
+		return BuildFileInfo{}, err
+	}
+
+	if buildFile.FileRef != "" {
+		fileElement, err := objects.Object(buildFile.FileRef)
+		if err != nil {
+			return BuildFileInfo{}, err
+		}
+
+		if buildFile.Path, err = optionalString(fileElement, "path"); err != nil {
+			return BuildFileInfo{}, err
+		}
+		if buildFile.Path == "" {
+			if buildFile.Path, err = optionalString(fileElement, "name"); err != nil {
+				return BuildFileInfo{}, err
+			}
+		}
+	}
+
+	settings, err := rawBuildFile.Object("settings")
+	if err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return buildFile, nil
+		}
+		return BuildFileInfo{}, err
+	}
+	buildFile.Settings = settings
+
+	if buildFile.CompilerFlags, err = optionalString(settings, "COMPILER_FLAGS"); err != nil {
+		return BuildFileInfo{}, err
+	}
+
+	attributes, err := settings.StringSlice("ATTRIBUTES")
+	if err != nil && !serialized.IsKeyNotFoundError(err) {
+		return BuildFileInfo{}, err
+	}
+	buildFile.Attributes = attributes
+
+	return buildFile, nil
+}
+
+// optionalString returns the string value of the key, or an empty string if the key is not found.
+func optionalString(object serialized.Object, key string) (string, error) {
+	value, err := object.String(key)
+	if err != nil && !serialized.IsKeyNotFoundError(err) {
+		return "", err
+	}
+	return value, nil
+}
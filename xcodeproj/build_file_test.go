@@ -0,0 +1,428 @@
+package xcodeproj
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_BuildFiles(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithBuildFiles))
+	require.NoError(t, err)
+
+	buildFiles, err := project.BuildFiles("App")
+	require.NoError(t, err)
+	require.Equal(t, []BuildFileInfo{
+		{
+			ID:        "E2B0F0112C8B4A0000A1B2C3",
+			Target:    "App",
+			PhaseID:   "E2B0F0212C8B4A0000A1B2C3",
+			PhaseType: "PBXSourcesBuildPhase",
+			PhaseName: "Sources",
+			FileRef:   "E2B0F0032C8B4A0000A1B2C3",
+			Path:      "AppDelegate.m",
+		},
+		{
+			ID:            "E2B0F0122C8B4A0000A1B2C3",
+			Target:        "App",
+			PhaseID:       "E2B0F0212C8B4A0000A1B2C3",
+			PhaseType:     "PBXSourcesBuildPhase",
+			PhaseName:     "Sources",
+			FileRef:       "E2B0F0042C8B4A0000A1B2C3",
+			Path:          "Legacy.m",
+			Settings:      serialized.Object{"COMPILER_FLAGS": "-fno-objc-arc"},
+			CompilerFlags: "-fno-objc-arc",
+		},
+		{
+			ID:        "E2B0F0162C8B4A0000A1B2C3",
+			Target:    "App",
+			PhaseID:   "E2B0F0222C8B4A0000A1B2C3",
+			PhaseType: "PBXFrameworksBuildPhase",
+			PhaseName: "Frameworks",
+			FileRef:   "E2B0F0022C8B4A0000A1B2C3",
+			Path:      "Kit.framework",
+		},
+		{
+			ID:        "E2B0F0182C8B4A0000A1B2C3",
+			Target:    "App",
+			PhaseID:   "E2B0F0232C8B4A0000A1B2C3",
+			PhaseType: "PBXResourcesBuildPhase",
+			PhaseName: "Resources",
+			FileRef:   "E2B0F0082C8B4A0000A1B2C3",
+			Path:      "Assets.xcassets",
+		},
+		{
+			ID:         "E2B0F0172C8B4A0000A1B2C3",
+			Target:     "App",
+			PhaseID:    "E2B0F0242C8B4A0000A1B2C3",
+			PhaseType:  "PBXCopyFilesBuildPhase",
+			PhaseName:  "Embed Frameworks",
+			FileRef:    "E2B0F0022C8B4A0000A1B2C3",
+			Path:       "Kit.framework",
+			Settings:   serialized.Object{"ATTRIBUTES": []interface{}{"CodeSignOnCopy", "RemoveHeadersOnCopy"}},
+			Attributes: []string{"CodeSignOnCopy", "RemoveHeadersOnCopy"},
+		},
+	}, buildFiles)
+
+	buildFiles, err = project.BuildFiles("Kit")
+	require.NoError(t, err)
+	require.Equal(t, 3, len(buildFiles))
+	require.Equal(t, "Kit.h", buildFiles[0].Path)
+	require.Equal(t, "Headers", buildFiles[0].PhaseName)
+	require.Equal(t, []string{"Public"}, buildFiles[0].Attributes)
+	require.Equal(t, "Internal.h", buildFiles[1].Path)
+	require.Equal(t, []string{"Private"}, buildFiles[1].Attributes)
+	require.Equal(t, "Kit.m", buildFiles[2].Path)
+	require.Equal(t, "-Wno-deprecated -DKIT=1", buildFiles[2].CompilerFlags)
+
+	_, err = project.BuildFiles("Missing")
+	require.Error(t, err)
+}
+
+func TestFixtureReplacements(t *testing.T) {
+	require.Empty(t, unappliedFixtureReplacements, "the fixtures extending another one do not match it anymore")
+}
+
+// unappliedFixtureReplacements collects the old strings of the fixture replacements which did not change the fixture.
+var unappliedFixtureReplacements []string
+
+// fixtureReplacer replaces the old-new string pairs in a fixture (like pbxprojWithBuildFiles) the way strings.Replacer does,
+// and records the pairs not applied to it (see TestFixtureReplacements),
+// so the fixtures extending another one can not silently drift from it.
+type fixtureReplacer struct {
+	oldnew []string
+}
+
+func newFixtureReplacer(oldnew ...string) fixtureReplacer {
+	return fixtureReplacer{oldnew: oldnew}
+}
+
+// Replace returns the fixture with the replacements applied.
+// A pair is not applied if the output is the same without it.
+func (r fixtureReplacer) Replace(fixture string) string {
+	replaced := strings.NewReplacer(r.oldnew...).Replace(fixture)
+
+	for i := 0; i < len(r.oldnew); i += 2 {
+		others := append(append([]string{}, r.oldnew[:i]...), r.oldnew[i+2:]...)
+		if strings.NewReplacer(others...).Replace(fixture) == replaced {
+			unappliedFixtureReplacements = append(unappliedFixtureReplacements, r.oldnew[i])
+		}
+	}
+
+	return replaced
+}
+
+const pbxprojWithBuildFiles = `// !$*UTF8*$!
+{
+	archiveVersion = 1;
+	classes = {
+	};
+	objectVersion = 56;
+	objects = {
+
+/* Begin PBXBuildFile section */
+		E2B0F0112C8B4A0000A1B2C3 /* AppDelegate.m in Sources */ = {isa = PBXBuildFile; fileRef = E2B0F0032C8B4A0000A1B2C3 /* AppDelegate.m */; };
+		E2B0F0122C8B4A0000A1B2C3 /* Legacy.m in Sources */ = {isa = PBXBuildFile; fileRef = E2B0F0042C8B4A0000A1B2C3 /* Legacy.m */; settings = {COMPILER_FLAGS = "-fno-objc-arc"; }; };
+		E2B0F0132C8B4A0000A1B2C3 /* Kit.h in Headers */ = {isa = PBXBuildFile; fileRef = E2B0F0052C8B4A0000A1B2C3 /* Kit.h */; settings = {ATTRIBUTES = (Public, ); }; };
+		E2B0F0142C8B4A0000A1B2C3 /* Internal.h in Headers */ = {isa = PBXBuildFile; fileRef = E2B0F0062C8B4A0000A1B2C3 /* Internal.h */; settings = {ATTRIBUTES = (Private, ); }; };
+		E2B0F0152C8B4A0000A1B2C3 /* Kit.m in Sources */ = {isa = PBXBuildFile; fileRef = E2B0F0072C8B4A0000A1B2C3 /* Kit.m */; settings = {COMPILER_FLAGS = "-Wno-deprecated -DKIT=1"; }; };
+		E2B0F0162C8B4A0000A1B2C3 /* Kit.framework in Frameworks */ = {isa = PBXBuildFile; fileRef = E2B0F0022C8B4A0000A1B2C3 /* Kit.framework */; };
+		E2B0F0172C8B4A0000A1B2C3 /* Kit.framework in Embed Frameworks */ = {isa = PBXBuildFile; fileRef = E2B0F0022C8B4A0000A1B2C3 /* Kit.framework */; settings = {ATTRIBUTES = (CodeSignOnCopy, RemoveHeadersOnCopy, ); }; };
+		E2B0F0182C8B4A0000A1B2C3 /* Assets.xcassets in Resources */ = {isa = PBXBuildFile; fileRef = E2B0F0082C8B4A0000A1B2C3 /* Assets.xcassets */; };
+/* End PBXBuildFile section */
+
+/* Begin PBXCopyFilesBuildPhase section */
+		E2B0F0242C8B4A0000A1B2C3 /* Embed Frameworks */ = {
+			isa = PBXCopyFilesBuildPhase;
+			buildActionMask = 2147483647;
+			dstPath = "";
+			dstSubfolderSpec = 10;
+			files = (
+				E2B0F0172C8B4A0000A1B2C3 /* Kit.framework in Embed Frameworks */,
+			);
+			name = "Embed Frameworks";
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXCopyFilesBuildPhase section */
+
+/* Begin PBXFileReference section */
+		E2B0F0012C8B4A0000A1B2C3 /* App.app */ = {isa = PBXFileReference; explicitFileType = wrapper.application; includeInIndex = 0; path = App.app; sourceTree = BUILT_PRODUCTS_DIR; };
+		E2B0F0022C8B4A0000A1B2C3 /* Kit.framework */ = {isa = PBXFileReference; explicitFileType = wrapper.framework; includeInIndex = 0; path = Kit.framework; sourceTree = BUILT_PRODUCTS_DIR; };
+		E2B0F0032C8B4A0000A1B2C3 /* AppDelegate.m */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.c.objc; path = AppDelegate.m; sourceTree = "<group>"; };
+		E2B0F0042C8B4A0000A1B2C3 /* Legacy.m */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.c.objc; path = Legacy.m; sourceTree = "<group>"; };
+		E2B0F0052C8B4A0000A1B2C3 /* Kit.h */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.c.h; path = Kit.h; sourceTree = "<group>"; };
+		E2B0F0062C8B4A0000A1B2C3 /* Internal.h */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.c.h; path = Internal.h; sourceTree = "<group>"; };
+		E2B0F0072C8B4A0000A1B2C3 /* Kit.m */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.c.objc; path = Kit.m; sourceTree = "<group>"; };
+		E2B0F0082C8B4A0000A1B2C3 /* Assets.xcassets */ = {isa = PBXFileReference; lastKnownFileType = folder.assetcatalog; path = Assets.xcassets; sourceTree = "<group>"; };
+/* End PBXFileReference section */
+
+/* Begin PBXFrameworksBuildPhase section */
+		E2B0F0222C8B4A0000A1B2C3 /* Frameworks */ = {
+			isa = PBXFrameworksBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				E2B0F0162C8B4A0000A1B2C3 /* Kit.framework in Frameworks */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+		E2B0F0272C8B4A0000A1B2C3 /* Frameworks */ = {
+			isa = PBXFrameworksBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXFrameworksBuildPhase section */
+
+/* Begin PBXGroup section */
+		E2B0F0302C8B4A0000A1B2C3 = {
+			isa = PBXGroup;
+			children = (
+				E2B0F0312C8B4A0000A1B2C3 /* App */,
+				E2B0F0322C8B4A0000A1B2C3 /* Kit */,
+				E2B0F0332C8B4A0000A1B2C3 /* Products */,
+			);
+			sourceTree = "<group>";
+		};
+		E2B0F0312C8B4A0000A1B2C3 /* App */ = {
+			isa = PBXGroup;
+			children = (
+				E2B0F0032C8B4A0000A1B2C3 /* AppDelegate.m */,
+				E2B0F0042C8B4A0000A1B2C3 /* Legacy.m */,
+				E2B0F0082C8B4A0000A1B2C3 /* Assets.xcassets */,
+			);
+			path = App;
+			sourceTree = "<group>";
+		};
+		E2B0F0322C8B4A0000A1B2C3 /* Kit */ = {
+			isa = PBXGroup;
+			children = (
+				E2B0F0052C8B4A0000A1B2C3 /* Kit.h */,
+				E2B0F0062C8B4A0000A1B2C3 /* Internal.h */,
+				E2B0F0072C8B4A0000A1B2C3 /* Kit.m */,
+			);
+			path = Kit;
+			sourceTree = "<group>";
+		};
+		E2B0F0332C8B4A0000A1B2C3 /* Products */ = {
+			isa = PBXGroup;
+			children = (
+				E2B0F0012C8B4A0000A1B2C3 /* App.app */,
+				E2B0F0022C8B4A0000A1B2C3 /* Kit.framework */,
+			);
+			name = Products;
+			sourceTree = "<group>";
+		};
+/* End PBXGroup section */
+
+/* Begin PBXHeadersBuildPhase section */
+		E2B0F0252C8B4A0000A1B2C3 /* Headers */ = {
+			isa = PBXHeadersBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				E2B0F0132C8B4A0000A1B2C3 /* Kit.h in Headers */,
+				E2B0F0142C8B4A0000A1B2C3 /* Internal.h in Headers */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXHeadersBuildPhase section */
+
+/* Begin PBXNativeTarget section */
+		E2B0F0402C8B4A0000A1B2C3 /* App */ = {
+			isa = PBXNativeTarget;
+			buildConfigurationList = E2B0F0612C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "App" */;
+			buildPhases = (
+				E2B0F0212C8B4A0000A1B2C3 /* Sources */,
+				E2B0F0222C8B4A0000A1B2C3 /* Frameworks */,
+				E2B0F0232C8B4A0000A1B2C3 /* Resources */,
+				E2B0F0242C8B4A0000A1B2C3 /* Embed Frameworks */,
+			);
+			buildRules = (
+			);
+			dependencies = (
+			);
+			name = App;
+			productName = App;
+			productReference = E2B0F0012C8B4A0000A1B2C3 /* App.app */;
+			productType = "com.apple.product-type.application";
+		};
+		E2B0F0412C8B4A0000A1B2C3 /* Kit */ = {
+			isa = PBXNativeTarget;
+			buildConfigurationList = E2B0F0622C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "Kit" */;
+			buildPhases = (
+				E2B0F0252C8B4A0000A1B2C3 /* Headers */,
+				E2B0F0262C8B4A0000A1B2C3 /* Sources */,
+				E2B0F0272C8B4A0000A1B2C3 /* Frameworks */,
+			);
+			buildRules = (
+			);
+			dependencies = (
+			);
+			name = Kit;
+			productName = Kit;
+			productReference = E2B0F0022C8B4A0000A1B2C3 /* Kit.framework */;
+			productType = "com.apple.product-type.framework";
+		};
+/* End PBXNativeTarget section */
+
+/* Begin PBXProject section */
+		E2B0F0502C8B4A0000A1B2C3 /* Project object */ = {
+			isa = PBXProject;
+			attributes = {
+				LastUpgradeCheck = 1500;
+			};
+			buildConfigurationList = E2B0F0602C8B4A0000A1B2C3 /* Build configuration list for PBXProject "App" */;
+			compatibilityVersion = "Xcode 14.0";
+			developmentRegion = en;
+			hasScannedForEncodings = 0;
+			knownRegions = (
+				en,
+				Base,
+			);
+			mainGroup = E2B0F0302C8B4A0000A1B2C3;
+			productRefGroup = E2B0F0332C8B4A0000A1B2C3 /* Products */;
+			projectDirPath = "";
+			projectRoot = "";
+			targets = (
+				E2B0F0402C8B4A0000A1B2C3 /* App */,
+				E2B0F0412C8B4A0000A1B2C3 /* Kit */,
+			);
+		};
+/* End PBXProject section */
+
+/* Begin PBXResourcesBuildPhase section */
+		E2B0F0232C8B4A0000A1B2C3 /* Resources */ = {
+			isa = PBXResourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				E2B0F0182C8B4A0000A1B2C3 /* Assets.xcassets in Resources */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXResourcesBuildPhase section */
+
+/* Begin PBXSourcesBuildPhase section */
+		E2B0F0212C8B4A0000A1B2C3 /* Sources */ = {
+			isa = PBXSourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				E2B0F0112C8B4A0000A1B2C3 /* AppDelegate.m in Sources */,
+				E2B0F0122C8B4A0000A1B2C3 /* Legacy.m in Sources */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+		E2B0F0262C8B4A0000A1B2C3 /* Sources */ = {
+			isa = PBXSourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				E2B0F0152C8B4A0000A1B2C3 /* Kit.m in Sources */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXSourcesBuildPhase section */
+
+/* Begin XCBuildConfiguration section */
+		E2B0F0702C8B4A0000A1B2C3 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				DEBUG_INFORMATION_FORMAT = dwarf;
+				ENABLE_TESTABILITY = YES;
+				GCC_OPTIMIZATION_LEVEL = 0;
+				IPHONEOS_DEPLOYMENT_TARGET = 15.0;
+				ONLY_ACTIVE_ARCH = YES;
+				SDKROOT = iphoneos;
+				SWIFT_OPTIMIZATION_LEVEL = "-Onone";
+			};
+			name = Debug;
+		};
+		E2B0F0712C8B4A0000A1B2C3 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				DEBUG_INFORMATION_FORMAT = "dwarf-with-dsym";
+				IPHONEOS_DEPLOYMENT_TARGET = 15.0;
+				SDKROOT = iphoneos;
+				SWIFT_COMPILATION_MODE = wholemodule;
+				SWIFT_OPTIMIZATION_LEVEL = "-O";
+				VALIDATE_PRODUCT = YES;
+			};
+			name = Release;
+		};
+		E2B0F0722C8B4A0000A1B2C3 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				INFOPLIST_FILE = App/Info.plist;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.App;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Debug;
+		};
+		E2B0F0732C8B4A0000A1B2C3 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				INFOPLIST_FILE = App/Info.plist;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.App;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Release;
+		};
+		E2B0F0742C8B4A0000A1B2C3 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				DEFINES_MODULE = YES;
+				INFOPLIST_FILE = Kit/Info.plist;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.Kit;
+				PRODUCT_NAME = "$(TARGET_NAME:c99extidentifier)";
+				SKIP_INSTALL = YES;
+			};
+			name = Debug;
+		};
+		E2B0F0752C8B4A0000A1B2C3 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				DEFINES_MODULE = YES;
+				INFOPLIST_FILE = Kit/Info.plist;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.Kit;
+				PRODUCT_NAME = "$(TARGET_NAME:c99extidentifier)";
+				SKIP_INSTALL = YES;
+			};
+			name = Release;
+		};
+/* End XCBuildConfiguration section */
+
+/* Begin XCConfigurationList section */
+		E2B0F0602C8B4A0000A1B2C3 /* Build configuration list for PBXProject "App" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				E2B0F0702C8B4A0000A1B2C3 /* Debug */,
+				E2B0F0712C8B4A0000A1B2C3 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+		E2B0F0612C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "App" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				E2B0F0722C8B4A0000A1B2C3 /* Debug */,
+				E2B0F0732C8B4A0000A1B2C3 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+		E2B0F0622C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "Kit" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				E2B0F0742C8B4A0000A1B2C3 /* Debug */,
+				E2B0F0752C8B4A0000A1B2C3 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+/* End XCConfigurationList section */
+	};
+	rootObject = E2B0F0502C8B4A0000A1B2C3 /* Project object */;
+}
+`
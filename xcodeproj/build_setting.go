@@ -0,0 +1,126 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// targetBuildConfigurations returns the target's build configurations with the given name,
+// or all of the target's build configurations if the configuration is empty.
+func (p XcodeProj) targetBuildConfigurations(target, configuration string) ([]BuildConfiguration, error) {
+	t, ok := p.Proj.TargetByName(target)
+	if !ok {
+		return nil, fmt.Errorf("target not found: %s", target)
+	}
+
+	var buildConfigurations []BuildConfiguration
+	for _, buildConfiguration := range t.BuildConfigurationList.BuildConfigurations {
+		if configuration == "" || buildConfiguration.Name == configuration {
+			buildConfigurations = append(buildConfigurations, buildConfiguration)
+		}
+	}
+
+	if len(buildConfigurations) == 0 {
+		return nil, fmt.Errorf("configuration (%s) not found for target: %s", configuration, target)
+	}
+	return buildConfigurations, nil
+}
+
+// setTargetBuildSetting sets the build setting in the target's build configurations with the given name,
+// or in all of the target's build configurations if the configuration is empty.
+// The project needs to be saved to persist the change.
+func (p XcodeProj) setTargetBuildSetting(target, configuration, key string, value interface{}) error {
+	buildConfigurations, err := p.targetBuildConfigurations(target, configuration)
+	if err != nil {
+		return err
+	}
+
+	for _, buildConfiguration := range buildConfigurations {
+		buildConfiguration.BuildSettings[key] = value
+	}
+	return nil
+}
+
+// inheritedBuildSettingList returns the list type build setting of the first build configuration (like the target's),
+// with the $(inherited) entries replaced by the setting of the next build configuration (like the project's).
+func inheritedBuildSettingList(buildConfigurations []BuildConfiguration, key string) ([]string, error) {
+	for i, buildConfiguration := range buildConfigurations {
+		if _, ok := buildConfiguration.BuildSettings[key]; !ok {
+			continue
+		}
+
+		entries, err := buildSettingList(buildConfiguration.BuildSettings, key)
+		if err != nil {
+			return nil, err
+		}
+
+		var resolved []string
+		for _, entry := range entries {
+			if !isInheritedReference(entry) {
+				resolved = append(resolved, entry)
+				continue
+			}
+
+			inherited, err := inheritedBuildSettingList(buildConfigurations[i+1:], key)
+			if err != nil {
+				return nil, err
+			}
+			resolved = append(resolved, inherited...)
+		}
+		return resolved, nil
+	}
+	return nil, nil
+}
+
+func isInheritedReference(entry string) bool {
+	return entry == inheritedBuildSetting || entry == "${inherited}"
+}
+
+// boolBuildSettingValue returns the YES/NO value of a boolean build setting.
+func boolBuildSettingValue(value bool) string {
+	if value {
+		return "YES"
+	}
+	return "NO"
+}
+
+// TargetBoolSetting returns the value of the target's boolean build setting (like ENABLE_BITCODE)
+// and whether it is set at all.
+// Besides YES and NO the YES_ prefixed variants (like YES_ERROR or YES_AGGRESSIVE) are accepted, which count as YES.
+func (p XcodeProj) TargetBoolSetting(target, configuration, key string) (bool, bool, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return false, false, err
+	}
+
+	return boolBuildSetting(buildSettings, key)
+}
+
+func boolBuildSetting(buildSettings serialized.Object, key string) (bool, bool, error) {
+	value, found, err := resolvedBuildSetting(buildSettings, key)
+	if err != nil {
+		return false, false, err
+	} else if !found || value == "" {
+		return false, false, nil
+	}
+
+	enabled, err := parseBoolBuildSettingValue(value)
+	if err != nil {
+		return false, false, fmt.Errorf("invalid %s build setting value: %s", key, err)
+	}
+	return enabled, true, nil
+}
+
+// parseBoolBuildSettingValue parses the YES/NO value of a boolean build setting, YES_ prefixed variants count as YES.
+func parseBoolBuildSettingValue(value string) (bool, error) {
+	switch normalized := strings.ToUpper(strings.TrimSpace(value)); {
+	case normalized == "YES" || strings.HasPrefix(normalized, "YES_"):
+		return true, nil
+	case normalized == "NO":
+		return false, nil
+	default:
+		return false, fmt.Errorf("not a boolean value: %s", value)
+	}
+}
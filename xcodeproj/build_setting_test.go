@@ -0,0 +1,68 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_boolBuildSetting(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     interface{}
+		wantValue bool
+		wantIsSet bool
+		wantErr   bool
+	}{
+		{name: "not set", value: nil},
+		{name: "empty", value: ""},
+		{name: "YES", value: "YES", wantValue: true, wantIsSet: true},
+		{name: "NO", value: "NO", wantValue: false, wantIsSet: true},
+		{name: "YES_ERROR", value: "YES_ERROR", wantValue: true, wantIsSet: true},
+		{name: "YES_AGGRESSIVE", value: "YES_AGGRESSIVE", wantValue: true, wantIsSet: true},
+		{name: "lowercase", value: "yes", wantValue: true, wantIsSet: true},
+		{name: "resolved reference", value: "$(ENABLE_FEATURE)", wantValue: true, wantIsSet: true},
+		{name: "invalid", value: "MAYBE", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buildSettings := serialized.Object{"ENABLE_FEATURE": "YES"}
+			if tt.value != nil {
+				buildSettings["ENABLE_BITCODE"] = tt.value
+			}
+
+			value, isSet, err := boolBuildSetting(buildSettings, "ENABLE_BITCODE")
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantValue, value)
+			require.Equal(t, tt.wantIsSet, isSet)
+		})
+	}
+}
+
+func TestXcodeProj_TargetBoolSetting(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithBuildFiles))
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(func(target, configuration string) (serialized.Object, error) {
+		return serialized.Object{"ENABLE_BITCODE": "NO", "CLANG_WARN_DOCUMENTATION_COMMENTS": "YES_ERROR"}, nil
+	})
+
+	value, isSet, err := project.TargetBoolSetting("App", "Debug", "ENABLE_BITCODE")
+	require.NoError(t, err)
+	require.True(t, isSet)
+	require.False(t, value)
+
+	value, isSet, err = project.TargetBoolSetting("App", "Debug", "CLANG_WARN_DOCUMENTATION_COMMENTS")
+	require.NoError(t, err)
+	require.True(t, isSet)
+	require.True(t, value)
+
+	value, isSet, err = project.TargetBoolSetting("App", "Debug", "ENABLE_TESTABILITY")
+	require.NoError(t, err)
+	require.False(t, isSet)
+	require.False(t, value)
+}
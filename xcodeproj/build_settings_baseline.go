@@ -0,0 +1,135 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// TargetBuildSettingsAll returns all of the target's build settings as strings, suitable for diffing against a baseline.
+// The references to other build settings are resolved where possible, unresolvable values are returned as they are.
+// List values are flattened to a space separated list, quoting the entries containing whitespace or quotes.
+func (p XcodeProj) TargetBuildSettingsAll(target, configuration string) (map[string]string, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return flattenBuildSettings(buildSettings)
+}
+
+// regexBuildSettingPrefix marks the required build setting values of VerifyBuildSettings which are regular expressions.
+const regexBuildSettingPrefix = "regex:"
+
+// SettingMismatch is a required build setting which is missing or has a different value.
+type SettingMismatch struct {
+	BuildSetting string
+	// Expected is the required value (or regex: prefixed regular expression).
+	Expected string
+	Actual   string
+	Missing  bool
+}
+
+// String ...
+func (m SettingMismatch) String() string {
+	if m.Missing {
+		return fmt.Sprintf("%s: not set, expected: %s", m.BuildSetting, m.Expected)
+	}
+	return fmt.Sprintf("%s = %s, expected: %s", m.BuildSetting, m.Actual, m.Expected)
+}
+
+// VerifyBuildSettings compares the target's build settings (see TargetBuildSettingsAll) to the required ones,
+// like GCC_TREAT_WARNINGS_AS_ERRORS = YES, and returns the missing and differing build settings, ordered by the build setting.
+// A required value prefixed with regex: is a regular expression the whole build setting value needs to match
+// (like regex:YES|YES_ERROR), any other value is matched exactly.
+func (p XcodeProj) VerifyBuildSettings(target, configuration string, required map[string]string) ([]SettingMismatch, error) {
+	settings, err := p.TargetBuildSettingsAll(target, configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return verifyBuildSettings(settings, required)
+}
+
+func verifyBuildSettings(settings, required map[string]string) ([]SettingMismatch, error) {
+	var mismatches []SettingMismatch
+	for _, key := range sortedStringMapKeys(required) {
+		expected := required[key]
+		value, ok := settings[key]
+		if !ok {
+			mismatches = append(mismatches, SettingMismatch{BuildSetting: key, Expected: expected, Missing: true})
+			continue
+		}
+
+		matches := value == expected
+		if pattern := strings.TrimPrefix(expected, regexBuildSettingPrefix); pattern != expected {
+			re, err := regexp.Compile("^(?:" + pattern + ")$")
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s build setting pattern: %s", key, err)
+			}
+			matches = re.MatchString(value)
+		}
+
+		if !matches {
+			mismatches = append(mismatches, SettingMismatch{BuildSetting: key, Expected: expected, Actual: value})
+		}
+	}
+	return mismatches, nil
+}
+
+func flattenBuildSettings(buildSettings serialized.Object) (map[string]string, error) {
+	settings := map[string]string{}
+	for _, key := range buildSettings.Keys() {
+		switch value := buildSettings[key].(type) {
+		case string:
+			settings[key] = resolveIfPossible(value, buildSettings)
+		case []interface{}:
+			entries, err := buildSettingList(buildSettings, key)
+			if err != nil {
+				return nil, err
+			}
+			for i, entry := range entries {
+				entries[i] = resolveIfPossible(entry, buildSettings)
+			}
+			settings[key] = joinBuildSettingList(entries)
+		default:
+			return nil, fmt.Errorf("unsupported %s build setting value: %v", key, value)
+		}
+	}
+	return settings, nil
+}
+
+func resolveIfPossible(value string, buildSettings serialized.Object) string {
+	if !strings.Contains(value, "$") {
+		return value
+	}
+	if resolved, err := Resolve(value, buildSettings); err == nil {
+		return resolved
+	}
+	return value
+}
+
+// joinBuildSettingList joins the entries to a list the way splitBuildSettingList splits it,
+// quoting the entries containing whitespace or quotes.
+func joinBuildSettingList(entries []string) string {
+	quoted := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry == "" || strings.ContainsAny(entry, " \t\n\"'\\") {
+			entry = `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(entry) + `"`
+		}
+		quoted = append(quoted, entry)
+	}
+	return strings.Join(quoted, " ")
+}
+
+func sortedStringMapKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
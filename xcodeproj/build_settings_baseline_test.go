@@ -0,0 +1,125 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_TargetBuildSettingsAll(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithBuildFiles))
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(baselineBuildSettingsProvider)
+
+	settings, err := project.TargetBuildSettingsAll("App", "Release")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"PRODUCT_NAME":              "App",
+		"PRODUCT_BUNDLE_IDENTIFIER": "io.bitrise.App",
+		"INFOPLIST_FILE":            "$(SRCROOT)/App/Info.plist",
+		"OTHER_LDFLAGS":             `$(inherited) -ObjC -framework "Vendor Kit"`,
+		"ENABLE_BITCODE":            "NO",
+	}, settings)
+}
+
+func TestXcodeProj_VerifyBuildSettings(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithBuildFiles))
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(baselineBuildSettingsProvider)
+
+	mismatches, err := project.VerifyBuildSettings("App", "Release", releaseBaseline)
+	require.NoError(t, err)
+	require.Equal(t, []SettingMismatch{
+		{
+			BuildSetting: "DEBUG_INFORMATION_FORMAT",
+			Expected:     "dwarf-with-dsym",
+			Missing:      true,
+		},
+		{
+			BuildSetting: "OTHER_LDFLAGS",
+			Expected:     "$(inherited) -ObjC",
+			Actual:       `$(inherited) -ObjC -framework "Vendor Kit"`,
+		},
+	}, mismatches)
+}
+
+func Test_verifyBuildSettings(t *testing.T) {
+	settings := map[string]string{
+		"GCC_TREAT_WARNINGS_AS_ERRORS":      "YES",
+		"CLANG_WARN_DOCUMENTATION_COMMENTS": "YES_ERROR",
+		"SWIFT_VERSION":                     "5.9",
+	}
+
+	tests := []struct {
+		name     string
+		required map[string]string
+		want     []SettingMismatch
+		wantErr  bool
+	}{
+		{
+			name: "matching baseline",
+			required: map[string]string{
+				"GCC_TREAT_WARNINGS_AS_ERRORS":      "YES",
+				"CLANG_WARN_DOCUMENTATION_COMMENTS": "regex:YES|YES_ERROR",
+				"SWIFT_VERSION":                     `regex:5\.\d+`,
+			},
+		},
+		{
+			name: "failing baseline",
+			required: map[string]string{
+				"GCC_TREAT_WARNINGS_AS_ERRORS": "NO",
+				"SWIFT_VERSION":                "regex:6",
+				"SWIFT_STRICT_CONCURRENCY":     "complete",
+			},
+			want: []SettingMismatch{
+				{BuildSetting: "GCC_TREAT_WARNINGS_AS_ERRORS", Expected: "NO", Actual: "YES"},
+				{BuildSetting: "SWIFT_STRICT_CONCURRENCY", Expected: "complete", Missing: true},
+				{BuildSetting: "SWIFT_VERSION", Expected: "regex:6", Actual: "5.9"},
+			},
+		},
+		{
+			name:     "invalid pattern",
+			required: map[string]string{"SWIFT_VERSION": "regex:(5"},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := verifyBuildSettings(settings, tt.required)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_joinBuildSettingList(t *testing.T) {
+	entries := []string{"$(inherited)", "$(SRCROOT)/Vendor Libs/**", `say "hi"`, ""}
+	joined := joinBuildSettingList(entries)
+	require.Equal(t, `$(inherited) "$(SRCROOT)/Vendor Libs/**" "say \"hi\"" ""`, joined)
+	require.Equal(t, entries, splitBuildSettingList(joined))
+}
+
+// baselineBuildSettingsProvider provides the same build settings for every target and configuration,
+// including an unresolved reference and a list type build setting.
+func baselineBuildSettingsProvider(target, configuration string) (serialized.Object, error) {
+	return serialized.Object{
+		"PRODUCT_NAME":              "App",
+		"PRODUCT_BUNDLE_IDENTIFIER": "io.bitrise.$(PRODUCT_NAME)",
+		"INFOPLIST_FILE":            "$(SRCROOT)/App/Info.plist",
+		"OTHER_LDFLAGS":             []interface{}{"$(inherited)", "-ObjC", "-framework", `"Vendor Kit"`},
+		"ENABLE_BITCODE":            "NO",
+	}, nil
+}
+
+// releaseBaseline are the build settings required in release configurations.
+var releaseBaseline = map[string]string{
+	"DEBUG_INFORMATION_FORMAT":  "dwarf-with-dsym",
+	"ENABLE_BITCODE":            "NO",
+	"OTHER_LDFLAGS":             "$(inherited) -ObjC",
+	"PRODUCT_BUNDLE_IDENTIFIER": "io.bitrise.App",
+}
@@ -0,0 +1,126 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// TargetBuiltProductPath predicts the path of the target's built product for the given configuration and sdk,
+// based on the TARGET_BUILD_DIR and FULL_PRODUCT_NAME build settings.
+// The sdk is optional, if empty the target's default sdk is used.
+func (p XcodeProj) TargetBuiltProductPath(target, configuration, sdk string) (string, error) {
+	var customOptions []string
+	if sdk != "" {
+		customOptions = append(customOptions, "-sdk", sdk)
+	}
+
+	buildSettings, err := p.TargetBuildSettings(target, configuration, customOptions...)
+	if err != nil {
+		return "", err
+	}
+
+	return builtProductPath(buildSettings)
+}
+
+// InstallableProductPaths returns the predicted built product paths of the installable (app and app clip) targets
+// mapped by target name.
+func (p XcodeProj) InstallableProductPaths(configuration, sdk string) (map[string]string, error) {
+	productPaths := map[string]string{}
+	for _, target := range p.Proj.Targets {
+		if !target.IsInstallableProduct() {
+			continue
+		}
+
+		pth, err := p.TargetBuiltProductPath(target.Name, configuration, sdk)
+		if err != nil {
+			return nil, err
+		}
+
+		productPaths[target.Name] = pth
+	}
+
+	return productPaths, nil
+}
+
+// TargetExecutableName returns the name of the target's Mach-O executable inside the product bundle.
+// The name is read from the EXECUTABLE_NAME build setting, falling back to the PRODUCT_NAME
+// (with the EXECUTABLE_PREFIX and EXECUTABLE_SUFFIX) and to the WRAPPER_NAME without its extension.
+func (p XcodeProj) TargetExecutableName(target, configuration string) (string, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return "", err
+	}
+
+	return executableName(buildSettings)
+}
+
+func executableName(buildSettings serialized.Object) (string, error) {
+	if name, found, err := resolvedBuildSetting(buildSettings, "EXECUTABLE_NAME"); err != nil {
+		return "", err
+	} else if found && name != "" {
+		return name, nil
+	}
+
+	if productName, found, err := resolvedBuildSetting(buildSettings, "PRODUCT_NAME"); err != nil {
+		return "", err
+	} else if found && productName != "" {
+		prefix, _, err := resolvedBuildSetting(buildSettings, "EXECUTABLE_PREFIX")
+		if err != nil {
+			return "", err
+		}
+		suffix, _, err := resolvedBuildSetting(buildSettings, "EXECUTABLE_SUFFIX")
+		if err != nil {
+			return "", err
+		}
+		return prefix + productName + suffix, nil
+	}
+
+	wrapperName, found, err := resolvedBuildSetting(buildSettings, "WRAPPER_NAME")
+	if err != nil {
+		return "", err
+	} else if !found || wrapperName == "" {
+		return "", fmt.Errorf("none of the build settings found: EXECUTABLE_NAME, PRODUCT_NAME, WRAPPER_NAME")
+	}
+	return strings.TrimSuffix(wrapperName, filepath.Ext(wrapperName)), nil
+}
+
+func builtProductPath(buildSettings serialized.Object) (string, error) {
+	dir, err := firstBuildSetting(buildSettings, "TARGET_BUILD_DIR", "BUILT_PRODUCTS_DIR", "CONFIGURATION_BUILD_DIR")
+	if err != nil {
+		return "", err
+	}
+
+	name, err := firstBuildSetting(buildSettings, "FULL_PRODUCT_NAME", "WRAPPER_NAME")
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, name), nil
+}
+
+// firstBuildSetting returns the resolved value of the first defined build setting from keys.
+func firstBuildSetting(buildSettings serialized.Object, keys ...string) (string, error) {
+	for _, key := range keys {
+		value, err := buildSettings.String(key)
+		if err != nil {
+			if serialized.IsKeyNotFoundError(err) {
+				continue
+			}
+			return "", err
+		}
+
+		if value == "" {
+			continue
+		}
+
+		if strings.Contains(value, "$") {
+			return Resolve(value, buildSettings)
+		}
+		return value, nil
+	}
+
+	return "", fmt.Errorf("none of the build settings found: %s", strings.Join(keys, ", "))
+}
@@ -0,0 +1,133 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/bitrise-io/go-plist"
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsInstallableProduct(t *testing.T) {
+	var raw serialized.Object
+	_, err := plist.Unmarshal([]byte(rawNativeTarget), &raw)
+	require.NoError(t, err)
+
+	app, err := parseTarget("13E76E0D1F4AC90A0028096E", raw)
+	require.NoError(t, err)
+	require.True(t, app.IsInstallableProduct())
+
+	extension, err := parseTarget("13E76E461F4AC94F0028096E", raw)
+	require.NoError(t, err)
+	require.False(t, extension.IsInstallableProduct())
+
+	appClip := Target{ProductType: appClipProductType, ProductReference: ProductReference{Path: "Clip.app"}}
+	require.True(t, appClip.IsInstallableProduct())
+
+	watchApp := Target{ProductType: "com.apple.product-type.application.watchapp2", ProductReference: ProductReference{Path: "Watch.app"}}
+	require.False(t, watchApp.IsInstallableProduct())
+}
+
+func Test_builtProductPath(t *testing.T) {
+	tests := []struct {
+		name          string
+		buildSettings serialized.Object
+		want          string
+		wantErr       bool
+	}{
+		{
+			name: "target build dir and full product name",
+			buildSettings: serialized.Object{
+				"TARGET_BUILD_DIR":  "/DerivedData/Build/Products/Release-iphoneos",
+				"FULL_PRODUCT_NAME": "Sample.app",
+			},
+			want: "/DerivedData/Build/Products/Release-iphoneos/Sample.app",
+		},
+		{
+			name: "unresolved build settings",
+			buildSettings: serialized.Object{
+				"BUILT_PRODUCTS_DIR": "$(BUILD_DIR)/Release-iphoneos",
+				"BUILD_DIR":          "/DerivedData/Build/Products",
+				"WRAPPER_NAME":       "$(PRODUCT_NAME).app",
+				"PRODUCT_NAME":       "Sample",
+			},
+			want: "/DerivedData/Build/Products/Release-iphoneos/Sample.app",
+		},
+		{
+			name: "missing product name",
+			buildSettings: serialized.Object{
+				"TARGET_BUILD_DIR": "/DerivedData/Build/Products/Release-iphoneos",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := builtProductPath(tt.buildSettings)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_executableName(t *testing.T) {
+	tests := []struct {
+		name          string
+		buildSettings serialized.Object
+		want          string
+		wantErr       bool
+	}{
+		{
+			name: "defaults to the product name",
+			buildSettings: serialized.Object{
+				"PRODUCT_NAME": "$(TARGET_NAME)",
+				"TARGET_NAME":  "Sample",
+			},
+			want: "Sample",
+		},
+		{
+			name: "overridden executable name",
+			buildSettings: serialized.Object{
+				"EXECUTABLE_NAME": "SampleBinary",
+				"PRODUCT_NAME":    "Sample",
+			},
+			want: "SampleBinary",
+		},
+		{
+			name: "executable prefix and suffix",
+			buildSettings: serialized.Object{
+				"EXECUTABLE_PREFIX": "lib",
+				"EXECUTABLE_SUFFIX": ".dylib",
+				"PRODUCT_NAME":      "Sample",
+			},
+			want: "libSample.dylib",
+		},
+		{
+			name: "wrapper name",
+			buildSettings: serialized.Object{
+				"WRAPPER_NAME": "Sample.app",
+			},
+			want: "Sample",
+		},
+		{
+			name:          "missing product name",
+			buildSettings: serialized.Object{},
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := executableName(tt.buildSettings)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
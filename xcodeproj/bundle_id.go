@@ -0,0 +1,52 @@
+package xcodeproj
+
+import (
+	"strings"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// BundleIDMatches reports whether the target's bundle id matches the expected one.
+// The bundle id is fully resolved, including the build setting reference modifiers (like rfc1034identifier).
+// The expected bundle id can be a wildcard pattern, like com.example.* or *, as used by the App IDs of provisioning profiles.
+func (p XcodeProj) BundleIDMatches(target, configuration, expected string) (bool, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return false, err
+	}
+
+	bundleID, err := p.unresolvedBundleID(buildSettings)
+	if err != nil {
+		return false, err
+	}
+
+	resolved, err := resolveBundleID(bundleID, buildSettings)
+	if err != nil {
+		return false, err
+	}
+
+	return bundleIDMatches(resolved, expected), nil
+}
+
+// bundleIDMatches compares the bundle id to the expected one, which can end with a * wildcard.
+func bundleIDMatches(bundleID, expected string) bool {
+	if strings.HasSuffix(expected, "*") {
+		return strings.HasPrefix(bundleID, strings.TrimSuffix(expected, "*"))
+	}
+	return bundleID == expected
+}
+
+// resolveBundleID resolves the build setting references in the bundle id, applying the references' modifiers.
+// Unlike Resolve, an error is returned for the unknown references of the $(KEY) and ${KEY} form.
+func resolveBundleID(bundleID string, buildSettings serialized.Object) (string, error) {
+	resolved, err := resolveReferences(bundleID, buildSettings, false)
+	if err != nil {
+		return "", err
+	}
+
+	if strings.Contains(resolved, "$") {
+		// references like $KEY
+		return Resolve(resolved, buildSettings)
+	}
+	return resolved, nil
+}
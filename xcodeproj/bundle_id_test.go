@@ -0,0 +1,77 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_resolveBundleID(t *testing.T) {
+	buildSettings := serialized.Object{
+		"PRODUCT_NAME":      "My App_2",
+		"BUNDLE_ID_PREFIX":  "io.bitrise",
+		"BUNDLE_ID_SUFFIX":  "$(PRODUCT_NAME:rfc1034identifier)",
+		"CYCLE":             "$(CYCLE)",
+		"TARGET_NAME":       "2nd target",
+		"EXECUTABLE_PREFIX": "Lib",
+	}
+
+	tests := []struct {
+		name     string
+		bundleID string
+		want     string
+		wantErr  bool
+	}{
+		{name: "no reference", bundleID: "io.bitrise.app", want: "io.bitrise.app"},
+		{name: "rfc1034identifier", bundleID: "io.bitrise.$(PRODUCT_NAME:rfc1034identifier)", want: "io.bitrise.My-App-2"},
+		{name: "c99extidentifier", bundleID: "io.bitrise.${TARGET_NAME:c99extidentifier}", want: "io.bitrise._2nd_target"},
+		{name: "chained modifiers", bundleID: "io.bitrise.$(PRODUCT_NAME:rfc1034identifier:lower)", want: "io.bitrise.my-app-2"},
+		{name: "nested reference", bundleID: "$(BUNDLE_ID_PREFIX).$(BUNDLE_ID_SUFFIX)", want: "io.bitrise.My-App-2"},
+		{name: "simple reference", bundleID: "io.bitrise.$EXECUTABLE_PREFIX", want: "io.bitrise.Lib"},
+		{name: "unknown reference", bundleID: "io.bitrise.$(MISSING)", wantErr: true},
+		{name: "reference cycle", bundleID: "io.bitrise.$(CYCLE)", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveBundleID(tt.bundleID, buildSettings)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestXcodeProj_BundleIDMatches(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithouthTargetAttributes))
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(func(target, configuration string) (serialized.Object, error) {
+		return serialized.Object{
+			"PRODUCT_BUNDLE_IDENTIFIER": "io.bitrise.$(PRODUCT_NAME:rfc1034identifier)",
+			"PRODUCT_NAME":              "My App",
+		}, nil
+	})
+
+	tests := []struct {
+		name     string
+		expected string
+		want     bool
+	}{
+		{name: "exact match", expected: "io.bitrise.My-App", want: true},
+		{name: "unresolved modifier", expected: "io.bitrise.My App", want: false},
+		{name: "different bundle id", expected: "io.bitrise.Other", want: false},
+		{name: "wildcard match", expected: "io.bitrise.*", want: true},
+		{name: "wildcard mismatch", expected: "com.example.*", want: false},
+		{name: "any bundle id", expected: "*", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := project.BundleIDMatches("Target", "Debug", tt.expected)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package xcodeproj
+
+import (
+	"fmt"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// PRODUCT_BUNDLE_PACKAGE_TYPE values
+const (
+	ApplicationPackageType = "APPL"
+	BundlePackageType      = "BNDL"
+	FrameworkPackageType   = "FMWK"
+	ExtensionPackageType   = "XPC!"
+)
+
+// defaultBundlePackageTypes maps the product types to the PRODUCT_BUNDLE_PACKAGE_TYPE Xcode uses if the build setting is not set.
+var defaultBundlePackageTypes = map[string]string{
+	appProductType:     ApplicationPackageType,
+	appClipProductType: ApplicationPackageType,
+	"com.apple.product-type.application.watchapp2": ApplicationPackageType,
+	"com.apple.product-type.app-extension":         ExtensionPackageType,
+	"com.apple.product-type.watchkit2-extension":   ExtensionPackageType,
+	frameworkProductType:                           FrameworkPackageType,
+	staticFrameworkProductType:                     FrameworkPackageType,
+	"com.apple.product-type.bundle":                BundlePackageType,
+	"com.apple.product-type.bundle.unit-test":      BundlePackageType,
+	"com.apple.product-type.bundle.ui-testing":     BundlePackageType,
+}
+
+// TargetBundlePackageType returns the target's PRODUCT_BUNDLE_PACKAGE_TYPE build setting (like APPL, BNDL or FMWK).
+// If the build setting is not set, the default of the target's product type is returned.
+func (p XcodeProj) TargetBundlePackageType(target, configuration string) (string, error) {
+	t, ok := p.Proj.TargetByName(target)
+	if !ok {
+		return "", fmt.Errorf("target not found: %s", target)
+	}
+
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return "", err
+	}
+
+	return bundlePackageType(buildSettings, t.ProductType)
+}
+
+func bundlePackageType(buildSettings serialized.Object, productType string) (string, error) {
+	value, found, err := resolvedBuildSetting(buildSettings, "PRODUCT_BUNDLE_PACKAGE_TYPE")
+	if err != nil {
+		return "", err
+	} else if found && value != "" {
+		return value, nil
+	}
+
+	if value, ok := defaultBundlePackageTypes[productType]; ok {
+		return value, nil
+	}
+	return "", fmt.Errorf("PRODUCT_BUNDLE_PACKAGE_TYPE not set and no default known for product type: %s", productType)
+}
@@ -0,0 +1,61 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_bundlePackageType(t *testing.T) {
+	tests := []struct {
+		name          string
+		buildSettings serialized.Object
+		productType   string
+		want          string
+		wantErr       bool
+	}{
+		{
+			name:        "app",
+			productType: "com.apple.product-type.application",
+			want:        ApplicationPackageType,
+		},
+		{
+			name:        "app extension",
+			productType: "com.apple.product-type.app-extension",
+			want:        ExtensionPackageType,
+		},
+		{
+			name:        "framework",
+			productType: "com.apple.product-type.framework",
+			want:        FrameworkPackageType,
+		},
+		{
+			name:        "unit test bundle",
+			productType: "com.apple.product-type.bundle.unit-test",
+			want:        BundlePackageType,
+		},
+		{
+			name:          "set by build setting",
+			buildSettings: serialized.Object{"PRODUCT_BUNDLE_PACKAGE_TYPE": "$(PACKAGE_TYPE)", "PACKAGE_TYPE": "BNDL"},
+			productType:   "com.apple.product-type.application",
+			want:          BundlePackageType,
+		},
+		{
+			name:        "static library",
+			productType: "com.apple.product-type.library.static",
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := bundlePackageType(tt.buildSettings, tt.productType)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+const (
+	resourcesBuildPhaseType = "PBXResourcesBuildPhase"
+	variantGroupElementType = "PBXVariantGroup"
+)
+
+// AllBundledResources returns the absolute paths of the resources merged into the target's product:
+// the files copied by the target's resources build phases, followed by the resources of the targets
+// whose products the target embeds (copies in a copy files build phase, like the Embed Frameworks or Embed App Extensions phases),
+// recursively, without duplicates.
+// The localized variants of a resource (like the Base and en Main.storyboard) are listed one by one.
+// The files excluded by the EXCLUDED_SOURCE_FILE_NAMES build setting of the copying target's configuration
+// (and not included by its INCLUDED_SOURCE_FILE_NAMES) are skipped, the embedded targets are read in the same configuration,
+// or in their default configuration if they do not have it, like xcodebuild does.
+func (p XcodeProj) AllBundledResources(targetName, configuration string) ([]string, error) {
+	objects, err := p.RawProj.Object("objects")
+	if err != nil {
+		return nil, err
+	}
+
+	productTargets := map[string]string{}
+	for _, target := range p.Proj.Targets {
+		rawTarget, err := objects.Object(target.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		productReference, err := optionalString(rawTarget, "productReference")
+		if err != nil {
+			return nil, err
+		}
+		if productReference != "" {
+			productTargets[productReference] = target.Name
+		}
+	}
+
+	var resources []string
+	seen := map[string]bool{}
+	visited := map[string]bool{}
+
+	var collect func(targetName, configuration string) error
+	collect = func(targetName, configuration string) error {
+		if visited[targetName] {
+			return nil
+		}
+		visited[targetName] = true
+
+		buildConfigurations, err := p.targetConfigurationLevels(targetName, configuration)
+		if err != nil {
+			return err
+		}
+		excluded, err := inheritedBuildSettingList(buildConfigurations, "EXCLUDED_SOURCE_FILE_NAMES")
+		if err != nil {
+			return err
+		}
+		included, err := inheritedBuildSettingList(buildConfigurations, "INCLUDED_SOURCE_FILE_NAMES")
+		if err != nil {
+			return err
+		}
+
+		buildFiles, err := p.BuildFiles(targetName)
+		if err != nil {
+			return err
+		}
+
+		var embeddedTargets []string
+		for _, buildFile := range buildFiles {
+			if buildFile.FileRef == "" {
+				continue
+			}
+
+			switch buildFile.PhaseType {
+			case resourcesBuildPhaseType:
+				paths, err := p.resourcePaths(buildFile.FileRef, objects)
+				if err != nil {
+					return fmt.Errorf("failed to resolve the path of resource (%s) of target (%s): %s", buildFile.FileRef, targetName, err)
+				}
+
+				for _, pth := range paths {
+					if seen[pth] || isExcludedSourceFile(pth, excluded, included) {
+						continue
+					}
+					seen[pth] = true
+					resources = append(resources, pth)
+				}
+			case copyFilesBuildPhaseType:
+				if embeddedTarget, ok := productTargets[buildFile.FileRef]; ok {
+					embeddedTargets = append(embeddedTargets, embeddedTarget)
+				}
+			}
+		}
+
+		for _, embeddedTarget := range embeddedTargets {
+			embeddedConfiguration := configuration
+			if target, ok := p.Proj.TargetByName(embeddedTarget); ok && !target.hasConfiguration(configuration) {
+				embeddedConfiguration = p.targetDefaultConfigurationName(target)
+			}
+
+			if err := collect(embeddedTarget, embeddedConfiguration); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := collect(targetName, configuration); err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+// resourcePaths returns the absolute path of the file reference,
+// or the paths of the localized variants if the reference is a variant group.
+func (p XcodeProj) resourcePaths(id string, objects serialized.Object) ([]string, error) {
+	object, err := objects.Object(id)
+	if err != nil {
+		return nil, err
+	}
+
+	isa, err := object.String("isa")
+	if err != nil {
+		return nil, err
+	}
+
+	if isa != variantGroupElementType {
+		pth, err := p.fileReferenceAbsolutePath(id, objects)
+		if err != nil {
+			return nil, err
+		}
+		return []string{pth}, nil
+	}
+
+	children, err := object.StringSlice("children")
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for _, child := range children {
+		pth, err := p.fileReferenceAbsolutePath(child, objects)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, pth)
+	}
+	return paths, nil
+}
+
+// isExcludedSourceFile reports whether the file matches any of the EXCLUDED_SOURCE_FILE_NAMES patterns
+// and none of the INCLUDED_SOURCE_FILE_NAMES patterns. The patterns are matched against the file name and the full path.
+func isExcludedSourceFile(pth string, excluded, included []string) bool {
+	return matchesSourceFilePattern(pth, excluded) && !matchesSourceFilePattern(pth, included)
+}
+
+func matchesSourceFilePattern(pth string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, err := path.Match(pattern, filepath.Base(pth)); err == nil && ok {
+			return true
+		}
+		if ok, err := path.Match(pattern, pth); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
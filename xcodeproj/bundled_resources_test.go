@@ -0,0 +1,145 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_AllBundledResources(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithFrameworkResources))
+	require.NoError(t, err)
+	project.Path = "/Users/bitrise/App/App.xcodeproj"
+
+	resources, err := project.AllBundledResources("App", "Debug")
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"/Users/bitrise/App/App/Assets.xcassets",
+		"/Users/bitrise/App/App/Mock.json",
+		"/Users/bitrise/App/App/Base.lproj/Main.storyboard",
+		"/Users/bitrise/App/App/en.lproj/Main.strings",
+		"/Users/bitrise/App/Kit/Kit.xcassets",
+	}, resources)
+
+	// Mock.json is excluded from the App target in Release
+	resources, err = project.AllBundledResources("App", "Release")
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"/Users/bitrise/App/App/Assets.xcassets",
+		"/Users/bitrise/App/App/Base.lproj/Main.storyboard",
+		"/Users/bitrise/App/App/en.lproj/Main.strings",
+		"/Users/bitrise/App/Kit/Kit.xcassets",
+	}, resources)
+
+	resources, err = project.AllBundledResources("Kit", "Release")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/Users/bitrise/App/Kit/Kit.xcassets"}, resources)
+
+	_, err = project.AllBundledResources("App", "Staging")
+	require.Error(t, err)
+}
+
+func TestXcodeProj_AllBundledResources_EmbeddedTargetConfiguration(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithFrameworkConfigurationNames))
+	require.NoError(t, err)
+	project.Path = "/Users/bitrise/App/App.xcodeproj"
+
+	// the Kit target has no Debug configuration, it is read in its default (Release) configuration
+	resources, err := project.AllBundledResources("App", "Debug")
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"/Users/bitrise/App/App/Assets.xcassets",
+		"/Users/bitrise/App/App/Mock.json",
+		"/Users/bitrise/App/App/Base.lproj/Main.storyboard",
+		"/Users/bitrise/App/App/en.lproj/Main.strings",
+		"/Users/bitrise/App/Kit/Kit.xcassets",
+	}, resources)
+}
+
+func Test_isExcludedSourceFile(t *testing.T) {
+	require.True(t, isExcludedSourceFile("/App/Mock.json", []string{"Mock*.json"}, nil))
+	require.True(t, isExcludedSourceFile("/App/Mocks/Users.json", []string{"/App/Mocks/*"}, nil))
+	require.False(t, isExcludedSourceFile("/App/MockUsers.json", []string{"Mock*.json"}, []string{"MockUsers.json"}))
+	require.False(t, isExcludedSourceFile("/App/Assets.xcassets", []string{"Mock*.json"}, nil))
+}
+
+// pbxprojWithFrameworkResources copies Assets.xcassets, Mock.json (excluded in Release) and the localized Main storyboard
+// in the App target, which embeds the Kit framework copying Kit.xcassets.
+var pbxprojWithFrameworkResources = newFixtureReplacer(
+	`/* End PBXBuildFile section */`, `		E2B0F0B32C8B4A0000A1B2C3 /* Kit.xcassets in Resources */ = {isa = PBXBuildFile; fileRef = E2B0F0B22C8B4A0000A1B2C3 /* Kit.xcassets */; };
+		E2B0F0B62C8B4A0000A1B2C3 /* Mock.json in Resources */ = {isa = PBXBuildFile; fileRef = E2B0F0B52C8B4A0000A1B2C3 /* Mock.json */; };
+		E2B0F0B92C8B4A0000A1B2C3 /* Main.storyboard in Resources */ = {isa = PBXBuildFile; fileRef = E2B0F0B72C8B4A0000A1B2C3 /* Main.storyboard */; };
+/* End PBXBuildFile section */`,
+	`/* End PBXFileReference section */`, `		E2B0F0B22C8B4A0000A1B2C3 /* Kit.xcassets */ = {isa = PBXFileReference; lastKnownFileType = folder.assetcatalog; path = Kit.xcassets; sourceTree = "<group>"; };
+		E2B0F0B52C8B4A0000A1B2C3 /* Mock.json */ = {isa = PBXFileReference; lastKnownFileType = text.json; path = Mock.json; sourceTree = "<group>"; };
+		E2B0F0B82C8B4A0000A1B2C3 /* Base */ = {isa = PBXFileReference; lastKnownFileType = file.storyboard; name = Base; path = Base.lproj/Main.storyboard; sourceTree = "<group>"; };
+		E2B0F0BA2C8B4A0000A1B2C3 /* en */ = {isa = PBXFileReference; lastKnownFileType = text.plist.strings; name = en; path = en.lproj/Main.strings; sourceTree = "<group>"; };
+/* End PBXFileReference section */`,
+	`				E2B0F0082C8B4A0000A1B2C3 /* Assets.xcassets */,
+			);`, `				E2B0F0082C8B4A0000A1B2C3 /* Assets.xcassets */,
+				E2B0F0B52C8B4A0000A1B2C3 /* Mock.json */,
+				E2B0F0B72C8B4A0000A1B2C3 /* Main.storyboard */,
+			);`,
+	`				E2B0F0072C8B4A0000A1B2C3 /* Kit.m */,
+			);`, `				E2B0F0072C8B4A0000A1B2C3 /* Kit.m */,
+				E2B0F0B22C8B4A0000A1B2C3 /* Kit.xcassets */,
+			);`,
+	`				E2B0F0182C8B4A0000A1B2C3 /* Assets.xcassets in Resources */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};`, `				E2B0F0182C8B4A0000A1B2C3 /* Assets.xcassets in Resources */,
+				E2B0F0B62C8B4A0000A1B2C3 /* Mock.json in Resources */,
+				E2B0F0B92C8B4A0000A1B2C3 /* Main.storyboard in Resources */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+		E2B0F0B42C8B4A0000A1B2C3 /* Resources */ = {
+			isa = PBXResourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				E2B0F0B32C8B4A0000A1B2C3 /* Kit.xcassets in Resources */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};`,
+	`				E2B0F0272C8B4A0000A1B2C3 /* Frameworks */,
+`, `				E2B0F0272C8B4A0000A1B2C3 /* Frameworks */,
+				E2B0F0B42C8B4A0000A1B2C3 /* Resources */,
+`,
+	`/* Begin XCBuildConfiguration section */`, `/* Begin PBXVariantGroup section */
+		E2B0F0B72C8B4A0000A1B2C3 /* Main.storyboard */ = {
+			isa = PBXVariantGroup;
+			children = (
+				E2B0F0B82C8B4A0000A1B2C3 /* Base */,
+				E2B0F0BA2C8B4A0000A1B2C3 /* en */,
+			);
+			name = Main.storyboard;
+			sourceTree = "<group>";
+		};
+/* End PBXVariantGroup section */
+
+/* Begin XCBuildConfiguration section */`,
+	`				INFOPLIST_FILE = App/Info.plist;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.App;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Release;`, `				EXCLUDED_SOURCE_FILE_NAMES = "Mock*.json";
+				INFOPLIST_FILE = App/Info.plist;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.App;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Release;`,
+).Replace(pbxprojWithBuildFiles)
+
+// pbxprojWithFrameworkConfigurationNames renames the Debug configuration of the Kit target to Development
+// in pbxprojWithFrameworkResources, so the App target's Debug configuration has no Kit counterpart.
+var pbxprojWithFrameworkConfigurationNames = newFixtureReplacer(
+	`				PRODUCT_NAME = "$(TARGET_NAME:c99extidentifier)";
+				SKIP_INSTALL = YES;
+			};
+			name = Debug;`, `				PRODUCT_NAME = "$(TARGET_NAME:c99extidentifier)";
+				SKIP_INSTALL = YES;
+			};
+			name = Development;`,
+).Replace(pbxprojWithFrameworkResources)
@@ -0,0 +1,206 @@
+package xcodeproj
+
+import (
+	"fmt"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+const (
+	appSandboxEntitlement = "com.apple.security.app-sandbox"
+	appSandboxCapability  = "com.apple.Sandbox"
+)
+
+// entitlementCapabilities maps the entitlements to the identifier of the capability (as used in SystemCapabilities) adding them.
+var entitlementCapabilities = map[string]string{
+	"aps-environment":                                  "com.apple.Push",
+	"com.apple.developer.associated-domains":           "com.apple.SafariKeychain",
+	"com.apple.developer.healthkit":                    "com.apple.HealthKit",
+	"com.apple.developer.homekit":                      "com.apple.HomeKit",
+	"com.apple.developer.icloud-container-identifiers": "com.apple.iCloud",
+	"com.apple.developer.in-app-payments":              "com.apple.ApplePay",
+	"com.apple.developer.networking.wifi-info":         "com.apple.AccessWiFi",
+	"com.apple.developer.nfc.readersession.formats":    "com.apple.NearFieldCommunicationTagReading",
+	"com.apple.developer.siri":                         "com.apple.Siri",
+	"com.apple.developer.ubiquity-kvstore-identifier":  "com.apple.iCloud",
+	appSandboxEntitlement:                              appSandboxCapability,
+	"keychain-access-groups":                           "com.apple.Keychain",
+}
+
+// TargetCapabilities returns the target's capabilities enabled state by the capability identifier (like com.apple.Push),
+// as stored in the project's TargetAttributes SystemCapabilities.
+// Projects created with newer Xcode versions store the capabilities in the entitlements file only,
+// in which case an empty map is returned.
+func (p XcodeProj) TargetCapabilities(target string) (map[string]bool, error) {
+	t, ok := p.Proj.TargetByName(target)
+	if !ok {
+		return nil, fmt.Errorf("target not found: %s", target)
+	}
+
+	capabilities := map[string]bool{}
+
+	targetAttributes, err := p.TargetAttributes()
+	if err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return capabilities, nil
+		}
+		return nil, err
+	}
+
+	attributes, err := targetAttributes.Object(t.ID)
+	if err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return capabilities, nil
+		}
+		return nil, err
+	}
+
+	systemCapabilities, err := attributes.Object("SystemCapabilities")
+	if err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return capabilities, nil
+		}
+		return nil, err
+	}
+
+	for _, identifier := range systemCapabilities.Keys() {
+		capability, err := systemCapabilities.Object(identifier)
+		if err != nil {
+			return nil, err
+		}
+
+		enabled, err := capability.Value("enabled")
+		if err != nil && !serialized.IsKeyNotFoundError(err) {
+			return nil, err
+		}
+		capabilities[identifier] = fmt.Sprint(enabled) == "1"
+	}
+
+	return capabilities, nil
+}
+
+// TargetSystemCapabilities returns the target's capabilities stored in the TargetAttributes SystemCapabilities,
+// it is an alias of TargetCapabilities. See TargetEnabledCapabilities for the capabilities combined with the entitlements.
+func (p XcodeProj) TargetSystemCapabilities(target string) (map[string]bool, error) {
+	return p.TargetCapabilities(target)
+}
+
+// TargetAppSandboxEnabled reports whether the macOS target has App Sandbox enabled.
+// The com.apple.security.app-sandbox entitlement is read first, falling back to the com.apple.Sandbox capability
+// if the target has no entitlements file or the entitlement is not set.
+func (p XcodeProj) TargetAppSandboxEnabled(target, configuration string) (bool, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return false, err
+	}
+
+	entitlements, err := p.entitlements(buildSettings)
+	if err != nil {
+		return false, err
+	}
+
+	capabilities, err := p.TargetCapabilities(target)
+	if err != nil {
+		return false, err
+	}
+
+	return appSandboxEnabled(entitlements, capabilities), nil
+}
+
+func appSandboxEnabled(entitlements serialized.Object, capabilities map[string]bool) bool {
+	if value, err := entitlements.Value(appSandboxEntitlement); err == nil {
+		enabled, ok := value.(bool)
+		return ok && enabled
+	}
+
+	return capabilities[appSandboxCapability]
+}
+
+// TargetEnabledCapabilities returns the target's capabilities enabled state by the capability identifier (like com.apple.HealthKit),
+// combining the TargetAttributes SystemCapabilities (see TargetCapabilities) with the capabilities implied by the target's entitlements.
+// An entitlement found in the entitlements file overrides the SystemCapabilities state: it is enabled unless set to false.
+func (p XcodeProj) TargetEnabledCapabilities(target, configuration string) (map[string]bool, error) {
+	capabilities, err := p.TargetCapabilities(target)
+	if err != nil {
+		return nil, err
+	}
+
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	entitlements, err := p.entitlements(buildSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeEntitlementCapabilities(capabilities, entitlements), nil
+}
+
+func mergeEntitlementCapabilities(capabilities map[string]bool, entitlements serialized.Object) map[string]bool {
+	merged := map[string]bool{}
+	for identifier, enabled := range capabilities {
+		merged[identifier] = enabled
+	}
+
+	entitlementEnabled := map[string]bool{}
+	for entitlement, value := range entitlements {
+		identifier, ok := entitlementCapabilities[entitlement]
+		if !ok {
+			continue
+		}
+
+		// a capability adding multiple entitlements (like iCloud) is enabled if any of them is
+		enabled, isBool := value.(bool)
+		entitlementEnabled[identifier] = entitlementEnabled[identifier] || !isBool || enabled
+	}
+
+	for identifier, enabled := range entitlementEnabled {
+		merged[identifier] = enabled
+	}
+	return merged
+}
+
+// SetSystemCapability sets the enabled state of the capability (like com.apple.Push) in the target's TargetAttributes SystemCapabilities,
+// creating the TargetAttributes entries if needed.
+// The project needs to be saved to persist the change.
+func (p XcodeProj) SetSystemCapability(targetName, capability string, enabled bool) error {
+	target, ok := p.Proj.TargetByName(targetName)
+	if !ok {
+		return fmt.Errorf("target not found: %s", targetName)
+	}
+
+	attributes, err := p.Attributes()
+	if err != nil {
+		return err
+	}
+
+	object := attributes
+	for _, key := range []string{"TargetAttributes", target.ID, "SystemCapabilities", capability} {
+		if object, err = childObject(object, key); err != nil {
+			return err
+		}
+	}
+
+	object["enabled"] = "0"
+	if enabled {
+		object["enabled"] = "1"
+	}
+	return nil
+}
+
+// childObject returns the object stored under the key, adding an empty object if the key is not found.
+func childObject(object serialized.Object, key string) (serialized.Object, error) {
+	child, err := object.Object(key)
+	if err == nil {
+		return child, nil
+	}
+	if !serialized.IsKeyNotFoundError(err) {
+		return nil, err
+	}
+
+	child = serialized.Object{}
+	object[key] = map[string]interface{}(child)
+	return child, nil
+}
@@ -0,0 +1,212 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_TargetCapabilities(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojMacOS))
+	require.NoError(t, err)
+
+	capabilities, err := project.TargetCapabilities("App")
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"com.apple.Sandbox": true, "com.apple.iCloud": false}, capabilities)
+
+	capabilities, err = project.TargetCapabilities("Kit")
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{}, capabilities)
+}
+
+func TestXcodeProj_TargetAppSandboxEnabled(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        string
+		buildSettings serialized.Object
+		want          bool
+	}{
+		{
+			name:          "sandbox entitlement",
+			target:        "Kit",
+			buildSettings: serialized.Object{"CODE_SIGN_ENTITLEMENTS": "App/App.entitlements"},
+			want:          true,
+		},
+		{
+			name:          "disabled sandbox entitlement overrides the capability",
+			target:        "App",
+			buildSettings: serialized.Object{"CODE_SIGN_ENTITLEMENTS": "App/NoSandbox.entitlements"},
+			want:          false,
+		},
+		{
+			name:   "sandbox capability without entitlements file",
+			target: "App",
+			want:   true,
+		},
+		{
+			name:   "no sandbox",
+			target: "Kit",
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectPth := createTmpProject(t, "App.xcodeproj", pbxprojMacOS, map[string]string{
+				"../App/App.entitlements":       macOSAppEntitlements,
+				"../App/NoSandbox.entitlements": macOSAppNoSandboxEntitlements,
+			})
+			project, err := Open(projectPth)
+			require.NoError(t, err)
+			project.SetBuildSettingsProvider(func(target, configuration string) (serialized.Object, error) {
+				return tt.buildSettings, nil
+			})
+
+			got, err := project.TargetAppSandboxEnabled(tt.target, "Release")
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_mergeEntitlementCapabilities(t *testing.T) {
+	capabilities := map[string]bool{"com.apple.Push": false, "com.apple.iCloud": false, "com.apple.Sandbox": true}
+	entitlements := serialized.Object{
+		"aps-environment": "production",
+		"com.apple.developer.icloud-container-identifiers": []interface{}{},
+		"com.apple.developer.ubiquity-kvstore-identifier":  "$(TeamIdentifierPrefix)$(CFBundleIdentifier)",
+		"com.apple.security.app-sandbox":                   false,
+		"com.apple.developer.team-identifier":              "72SA8V3WYL",
+	}
+
+	require.Equal(t, map[string]bool{
+		"com.apple.Push":    true,
+		"com.apple.iCloud":  true,
+		"com.apple.Sandbox": false,
+	}, mergeEntitlementCapabilities(capabilities, entitlements))
+	require.Equal(t, map[string]bool{"com.apple.Push": false, "com.apple.iCloud": false, "com.apple.Sandbox": true}, capabilities)
+}
+
+func TestXcodeProj_TargetEnabledCapabilities(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithSystemCapabilities, map[string]string{
+		"../App/App.entitlements": associatedDomainsEntitlements,
+	})
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(func(target, configuration string) (serialized.Object, error) {
+		return serialized.Object{"CODE_SIGN_ENTITLEMENTS": "App/App.entitlements"}, nil
+	})
+
+	capabilities, err := project.TargetSystemCapabilities("App")
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"com.apple.HealthKit": true, "com.apple.Push": true, "com.apple.SafariKeychain": false}, capabilities)
+
+	_, err = project.TargetSystemCapabilities("Missing")
+	require.Error(t, err)
+
+	capabilities, err = project.TargetEnabledCapabilities("App", "Release")
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"com.apple.HealthKit": true, "com.apple.Push": true, "com.apple.SafariKeychain": true}, capabilities)
+}
+
+func TestXcodeProj_SetSystemCapability(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithSystemCapabilities, nil)
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+
+	require.NoError(t, project.SetSystemCapability("App", "com.apple.Push", false))
+	require.NoError(t, project.SetSystemCapability("App", "com.apple.iCloud", true))
+	require.NoError(t, project.SetSystemCapability("Kit", "com.apple.HealthKit", true))
+	require.Error(t, project.SetSystemCapability("Missing", "com.apple.HealthKit", true))
+	require.NoError(t, project.Save())
+
+	project, err = Open(projectPth)
+	require.NoError(t, err)
+
+	capabilities, err := project.TargetCapabilities("App")
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"com.apple.HealthKit": true, "com.apple.Push": false, "com.apple.SafariKeychain": false, "com.apple.iCloud": true}, capabilities)
+
+	capabilities, err = project.TargetCapabilities("Kit")
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"com.apple.HealthKit": true}, capabilities)
+}
+
+// pbxprojWithSystemCapabilities enables the HealthKit and Push Notifications capabilities of the App target in its SystemCapabilities,
+// the Associated Domains capability is disabled there but enabled by the entitlements file.
+var pbxprojWithSystemCapabilities = newFixtureReplacer(
+	`				LastUpgradeCheck = 1500;
+`, `				LastUpgradeCheck = 1500;
+				TargetAttributes = {
+					E2B0F0402C8B4A0000A1B2C3 = {
+						CreatedOnToolsVersion = 9.4.1;
+						SystemCapabilities = {
+							com.apple.HealthKit = {
+								enabled = 1;
+							};
+							com.apple.Push = {
+								enabled = 1;
+							};
+							com.apple.SafariKeychain = {
+								enabled = 0;
+							};
+						};
+					};
+				};
+`,
+).Replace(pbxprojWithBuildFiles)
+
+const associatedDomainsEntitlements = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>com.apple.developer.associated-domains</key>
+	<array>
+		<string>applinks:bitrise.io</string>
+	</array>
+</dict>
+</plist>
+`
+
+var pbxprojMacOS = newFixtureReplacer(
+	"SDKROOT = iphoneos;", "SDKROOT = macosx;",
+	"IPHONEOS_DEPLOYMENT_TARGET = 15.0;", "MACOSX_DEPLOYMENT_TARGET = 13.0;",
+	`				LastUpgradeCheck = 1500;
+`, `				LastUpgradeCheck = 1500;
+				TargetAttributes = {
+					E2B0F0402C8B4A0000A1B2C3 = {
+						CreatedOnToolsVersion = 9.4.1;
+						SystemCapabilities = {
+							com.apple.Sandbox = {
+								enabled = 1;
+							};
+							com.apple.iCloud = {
+								enabled = 0;
+							};
+						};
+					};
+				};
+`,
+).Replace(pbxprojWithBuildFiles)
+
+const macOSAppEntitlements = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>com.apple.security.app-sandbox</key>
+	<true/>
+	<key>com.apple.security.files.user-selected.read-only</key>
+	<true/>
+</dict>
+</plist>
+`
+
+const macOSAppNoSandboxEntitlements = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>com.apple.security.app-sandbox</key>
+	<false/>
+</dict>
+</plist>
+`
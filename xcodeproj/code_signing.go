@@ -0,0 +1,102 @@
+package xcodeproj
+
+import (
+	"strings"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// hardenedRuntimeOption is the codesign --options value enabling the hardened runtime.
+const hardenedRuntimeOption = "runtime"
+
+// CodeSigningRequirements are the target's code signing settings required for macOS distribution (notarization).
+type CodeSigningRequirements struct {
+	// HardenedRuntime is enabled either by the ENABLE_HARDENED_RUNTIME build setting
+	// or by the runtime codesign option in OTHER_CODE_SIGN_FLAGS.
+	HardenedRuntime bool
+	// InjectBaseEntitlements is the CODE_SIGN_INJECT_BASE_ENTITLEMENTS build setting (defaults to YES),
+	// which injects the com.apple.security.get-task-allow entitlement, rejected by notarization.
+	InjectBaseEntitlements bool
+	// OtherCodeSignFlags are the entries of the OTHER_CODE_SIGN_FLAGS build setting.
+	OtherCodeSignFlags []string
+	// RuntimeOptions are the codesign --options values (like runtime or library) found in OTHER_CODE_SIGN_FLAGS.
+	RuntimeOptions []string
+}
+
+// TargetCodeSigningRequirements returns the target's code signing settings used for macOS distribution.
+func (p XcodeProj) TargetCodeSigningRequirements(target, configuration string) (CodeSigningRequirements, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return CodeSigningRequirements{}, err
+	}
+
+	return codeSigningRequirements(buildSettings)
+}
+
+// TargetHardenedRuntimeEnabled reports whether the target is signed with the hardened runtime,
+// either by the ENABLE_HARDENED_RUNTIME build setting or by the runtime codesign option in OTHER_CODE_SIGN_FLAGS.
+func (p XcodeProj) TargetHardenedRuntimeEnabled(target, configuration string) (bool, error) {
+	requirements, err := p.TargetCodeSigningRequirements(target, configuration)
+	if err != nil {
+		return false, err
+	}
+	return requirements.HardenedRuntime, nil
+}
+
+func codeSigningRequirements(buildSettings serialized.Object) (CodeSigningRequirements, error) {
+	hardenedRuntime, _, err := boolBuildSetting(buildSettings, "ENABLE_HARDENED_RUNTIME")
+	if err != nil {
+		return CodeSigningRequirements{}, err
+	}
+
+	injectBaseEntitlements, isSet, err := boolBuildSetting(buildSettings, "CODE_SIGN_INJECT_BASE_ENTITLEMENTS")
+	if err != nil {
+		return CodeSigningRequirements{}, err
+	} else if !isSet {
+		injectBaseEntitlements = true
+	}
+
+	otherCodeSignFlags, err := buildSettingList(buildSettings, "OTHER_CODE_SIGN_FLAGS")
+	if err != nil {
+		return CodeSigningRequirements{}, err
+	}
+
+	runtimeOptions := codeSignOptions(otherCodeSignFlags)
+	for _, option := range runtimeOptions {
+		if option == hardenedRuntimeOption {
+			hardenedRuntime = true
+		}
+	}
+
+	return CodeSigningRequirements{
+		HardenedRuntime:        hardenedRuntime,
+		InjectBaseEntitlements: injectBaseEntitlements,
+		OtherCodeSignFlags:     otherCodeSignFlags,
+		RuntimeOptions:         runtimeOptions,
+	}, nil
+}
+
+// codeSignOptions returns the values of the codesign --options (-o) flags,
+// given as --options=runtime,library, --options runtime or -o runtime.
+func codeSignOptions(flags []string) []string {
+	var options []string
+	for i := 0; i < len(flags); i++ {
+		var value string
+		switch flag := flags[i]; {
+		case strings.HasPrefix(flag, "--options="):
+			value = strings.TrimPrefix(flag, "--options=")
+		case (flag == "--options" || flag == "-o") && i+1 < len(flags):
+			i++
+			value = flags[i]
+		default:
+			continue
+		}
+
+		for _, option := range strings.Split(value, ",") {
+			if option = strings.TrimSpace(option); option != "" {
+				options = append(options, option)
+			}
+		}
+	}
+	return options
+}
@@ -0,0 +1,84 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_codeSignOptions(t *testing.T) {
+	tests := []struct {
+		name  string
+		flags []string
+		want  []string
+	}{
+		{name: "no flags"},
+		{name: "equals form", flags: []string{"--timestamp", "--options=runtime,library"}, want: []string{"runtime", "library"}},
+		{name: "separate value", flags: []string{"--options", "runtime"}, want: []string{"runtime"}},
+		{name: "short form", flags: []string{"-o", "runtime", "--deep"}, want: []string{"runtime"}},
+		{name: "missing value", flags: []string{"--options"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, codeSignOptions(tt.flags))
+		})
+	}
+}
+
+func TestXcodeProj_TargetCodeSigningRequirements(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojMacOSApp))
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(*project))
+
+	requirements, err := project.TargetCodeSigningRequirements("App", "Release")
+	require.NoError(t, err)
+	require.Equal(t, CodeSigningRequirements{
+		HardenedRuntime:        true,
+		InjectBaseEntitlements: false,
+		OtherCodeSignFlags:     []string{"--timestamp", "--options=runtime,library"},
+		RuntimeOptions:         []string{"runtime", "library"},
+	}, requirements)
+
+	requirements, err = project.TargetCodeSigningRequirements("App", "Debug")
+	require.NoError(t, err)
+	require.Equal(t, CodeSigningRequirements{
+		HardenedRuntime:        true,
+		InjectBaseEntitlements: true,
+	}, requirements)
+
+	enabled, err := project.TargetHardenedRuntimeEnabled("Kit", "Release")
+	require.NoError(t, err)
+	require.False(t, enabled)
+}
+
+// pbxprojMacOSApp turns pbxprojWithBuildFiles into a macOS project:
+// the App target has the hardened runtime enabled, and its Release configuration is set up for notarization.
+var pbxprojMacOSApp = newFixtureReplacer(
+	`				IPHONEOS_DEPLOYMENT_TARGET = 15.0;
+				ONLY_ACTIVE_ARCH = YES;
+				SDKROOT = iphoneos;
+`, `				MACOSX_DEPLOYMENT_TARGET = 13.0;
+				ONLY_ACTIVE_ARCH = YES;
+				SDKROOT = macosx;
+`,
+	`				IPHONEOS_DEPLOYMENT_TARGET = 15.0;
+				SDKROOT = iphoneos;
+`, `				MACOSX_DEPLOYMENT_TARGET = 13.0;
+				SDKROOT = macosx;
+`,
+	`				PRODUCT_NAME = "$(TARGET_NAME)";
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Debug;`, `				ENABLE_HARDENED_RUNTIME = YES;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+			};
+			name = Debug;`,
+	`				PRODUCT_NAME = "$(TARGET_NAME)";
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Release;`, `				CODE_SIGN_INJECT_BASE_ENTITLEMENTS = NO;
+				OTHER_CODE_SIGN_FLAGS = "--timestamp --options=runtime,library";
+				PRODUCT_NAME = "$(TARGET_NAME)";
+			};
+			name = Release;`,
+).Replace(pbxprojWithBuildFiles)
@@ -0,0 +1,150 @@
+package xcodeproj
+
+import (
+	"reflect"
+	"strings"
+)
+
+// TargetSwiftCompilationConditions returns the target's SWIFT_ACTIVE_COMPILATION_CONDITIONS build setting,
+// the flags available for Swift #if directives (like DEBUG), as set in the project file.
+// The target level $(inherited) entries are replaced by the project level conditions.
+func (p XcodeProj) TargetSwiftCompilationConditions(target, configuration string) ([]string, error) {
+	buildConfigurations, err := p.targetConfigurationLevels(target, configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return inheritedBuildSettingList(buildConfigurations, "SWIFT_ACTIVE_COMPILATION_CONDITIONS")
+}
+
+// TargetSwiftDefines returns every flag defined for the target's Swift #if directives:
+// the SWIFT_ACTIVE_COMPILATION_CONDITIONS (see TargetSwiftCompilationConditions),
+// followed by the -D flags of the OTHER_SWIFT_FLAGS build setting (like -D CI or -DCI), without duplicates.
+func (p XcodeProj) TargetSwiftDefines(target, configuration string) ([]string, error) {
+	buildConfigurations, err := p.targetConfigurationLevels(target, configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	conditions, err := inheritedBuildSettingList(buildConfigurations, "SWIFT_ACTIVE_COMPILATION_CONDITIONS")
+	if err != nil {
+		return nil, err
+	}
+
+	flags, err := inheritedBuildSettingList(buildConfigurations, "OTHER_SWIFT_FLAGS")
+	if err != nil {
+		return nil, err
+	}
+
+	var defines []string
+	seen := map[string]bool{}
+	for _, define := range append(conditions, swiftFlagDefines(flags)...) {
+		if seen[define] {
+			continue
+		}
+		seen[define] = true
+		defines = append(defines, define)
+	}
+	return defines, nil
+}
+
+// swiftPassThroughFlags are the Swift compiler flags passing the following argument to another tool,
+// like -Xcc -DFOO=1 defining a macro for the Clang importer.
+var swiftPassThroughFlags = map[string]bool{
+	"-Xcc":       true,
+	"-Xfrontend": true,
+	"-Xllvm":     true,
+	"-Xlinker":   true,
+}
+
+// swiftFlagDefines returns the flags defined by the -D options of the Swift compiler flags,
+// both the separate (-D CI) and the joined (-DCI) forms.
+// The arguments passed through to other tools (like -Xcc -DFOO=1) are skipped.
+func swiftFlagDefines(flags []string) []string {
+	var defines []string
+	for i := 0; i < len(flags); i++ {
+		flag := flags[i]
+		if swiftPassThroughFlags[flag] {
+			i++
+			continue
+		}
+		if flag == "-D" {
+			if i+1 < len(flags) {
+				defines = append(defines, flags[i+1])
+				i++
+			}
+			continue
+		}
+		if strings.HasPrefix(flag, "-D") {
+			defines = append(defines, strings.TrimPrefix(flag, "-D"))
+		}
+	}
+	return defines
+}
+
+// AddSwiftCompilationCondition adds the condition (like CI) to the target's SWIFT_ACTIVE_COMPILATION_CONDITIONS build setting
+// in the given configuration, or in all of the target's configurations if the configuration is empty.
+// If the target does not set the build setting yet, it is set to inherit the project level conditions, followed by the condition.
+// The project needs to be saved to persist the change.
+func (p XcodeProj) AddSwiftCompilationCondition(target, configuration, condition string) error {
+	return p.updateSwiftCompilationConditions(target, configuration, func(conditions []string) []string {
+		for _, c := range conditions {
+			if c == condition {
+				return conditions
+			}
+		}
+		return append(conditions, condition)
+	})
+}
+
+// RemoveSwiftCompilationCondition removes the condition from the target's SWIFT_ACTIVE_COMPILATION_CONDITIONS build setting
+// in the given configuration, or in all of the target's configurations if the configuration is empty.
+// Conditions inherited from the project level are not affected.
+// The project needs to be saved to persist the change.
+func (p XcodeProj) RemoveSwiftCompilationCondition(target, configuration, condition string) error {
+	return p.updateSwiftCompilationConditions(target, configuration, func(conditions []string) []string {
+		var kept []string
+		for _, c := range conditions {
+			if c != condition {
+				kept = append(kept, c)
+			}
+		}
+		return kept
+	})
+}
+
+func (p XcodeProj) updateSwiftCompilationConditions(target, configuration string, update func([]string) []string) error {
+	const key = "SWIFT_ACTIVE_COMPILATION_CONDITIONS"
+
+	buildConfigurations, err := p.targetBuildConfigurations(target, configuration)
+	if err != nil {
+		return err
+	}
+
+	for _, buildConfiguration := range buildConfigurations {
+		value, isSet := buildConfiguration.BuildSettings[key]
+
+		conditions := []string{inheritedBuildSetting}
+		if isSet {
+			if conditions, err = buildSettingList(buildConfiguration.BuildSettings, key); err != nil {
+				return err
+			}
+		}
+
+		updated := update(conditions)
+		if reflect.DeepEqual(conditions, updated) {
+			continue
+		}
+
+		if _, isArray := value.([]interface{}); isArray {
+			var items []interface{}
+			for _, condition := range updated {
+				items = append(items, condition)
+			}
+			buildConfiguration.BuildSettings[key] = items
+		} else {
+			buildConfiguration.BuildSettings[key] = strings.Join(updated, " ")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_TargetSwiftCompilationConditions(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithCompilationConditions))
+	require.NoError(t, err)
+
+	tests := []struct {
+		target        string
+		configuration string
+		want          []string
+	}{
+		{target: "App", configuration: "Debug", want: []string{"DEBUG", "MOCK_API"}},
+		{target: "App", configuration: "Release", want: []string{"APP_STORE"}},
+		{target: "Kit", configuration: "Debug", want: []string{"DEBUG"}},
+		{target: "Kit", configuration: "Release", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target+" "+tt.configuration, func(t *testing.T) {
+			got, err := project.TargetSwiftCompilationConditions(tt.target, tt.configuration)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestXcodeProj_TargetSwiftDefines(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithOtherSwiftFlags))
+	require.NoError(t, err)
+
+	tests := []struct {
+		target        string
+		configuration string
+		want          []string
+	}{
+		{target: "App", configuration: "Debug", want: []string{"DEBUG", "MOCK_API", "LOGGING", "CI"}},
+		{target: "App", configuration: "Release", want: []string{"APP_STORE"}},
+		{target: "Kit", configuration: "Debug", want: []string{"DEBUG", "LOGGING"}},
+		{target: "Kit", configuration: "Release", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target+" "+tt.configuration, func(t *testing.T) {
+			got, err := project.TargetSwiftDefines(tt.target, tt.configuration)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err = project.TargetSwiftDefines("Missing", "Debug")
+	require.Error(t, err)
+}
+
+func Test_swiftFlagDefines(t *testing.T) {
+	require.Equal(t, []string{"CI", "STAGING"}, swiftFlagDefines([]string{"-D", "CI", "-Onone", "-DSTAGING", "-Xfrontend", "-warn-long-function-bodies=100"}))
+	require.Nil(t, swiftFlagDefines([]string{"-D"}))
+	require.Equal(t, []string{"CI"}, swiftFlagDefines([]string{"-Xcc", "-DFOO=1", "-DCI", "-Xcc", "-D", "-Xcc", "BAR"}))
+}
+
+func TestXcodeProj_AddSwiftCompilationCondition(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithCompilationConditions, nil)
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+
+	require.NoError(t, project.AddSwiftCompilationCondition("App", "", "CI"))
+	require.NoError(t, project.AddSwiftCompilationCondition("Kit", "Debug", "CI"))
+	require.NoError(t, project.RemoveSwiftCompilationCondition("App", "Debug", "MOCK_API"))
+	require.NoError(t, project.RemoveSwiftCompilationCondition("Kit", "Debug", "DEBUG"))
+	require.Error(t, project.AddSwiftCompilationCondition("App", "Missing", "CI"))
+	require.NoError(t, project.Save())
+
+	project, err = Open(projectPth)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		target        string
+		configuration string
+		want          []string
+	}{
+		{target: "App", configuration: "Debug", want: []string{"DEBUG", "CI"}},
+		{target: "App", configuration: "Release", want: []string{"APP_STORE", "CI"}},
+		{target: "Kit", configuration: "Debug", want: []string{"DEBUG", "CI"}},
+		{target: "Kit", configuration: "Release", want: nil},
+	} {
+		got, err := project.TargetSwiftCompilationConditions(tt.target, tt.configuration)
+		require.NoError(t, err)
+		require.Equal(t, tt.want, got, tt.target+" "+tt.configuration)
+	}
+}
+
+func TestXcodeProj_RemoveSwiftCompilationCondition_Unset(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithCompilationConditions))
+	require.NoError(t, err)
+
+	require.NoError(t, project.RemoveSwiftCompilationCondition("Kit", "", "DEBUG"))
+
+	buildConfigurations, err := project.targetBuildConfigurations("Kit", "")
+	require.NoError(t, err)
+	for _, buildConfiguration := range buildConfigurations {
+		_, isSet := buildConfiguration.BuildSettings["SWIFT_ACTIVE_COMPILATION_CONDITIONS"]
+		require.False(t, isSet, buildConfiguration.Name)
+	}
+}
+
+// pbxprojWithCompilationConditions sets DEBUG at the project level in Debug,
+// the App target extends it in Debug and overrides it in Release.
+var pbxprojWithCompilationConditions = newFixtureReplacer(
+	`				ONLY_ACTIVE_ARCH = YES;
+`, `				ONLY_ACTIVE_ARCH = YES;
+				SWIFT_ACTIVE_COMPILATION_CONDITIONS = "DEBUG $(inherited)";
+`,
+	`				PRODUCT_NAME = "$(TARGET_NAME)";
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Debug;`, `				PRODUCT_NAME = "$(TARGET_NAME)";
+				SWIFT_ACTIVE_COMPILATION_CONDITIONS = (
+					"$(inherited)",
+					MOCK_API,
+				);
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Debug;`,
+	`				PRODUCT_NAME = "$(TARGET_NAME)";
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Release;`, `				PRODUCT_NAME = "$(TARGET_NAME)";
+				SWIFT_ACTIVE_COMPILATION_CONDITIONS = APP_STORE;
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Release;`,
+).Replace(pbxprojWithBuildFiles)
+
+// pbxprojWithOtherSwiftFlags extends pbxprojWithCompilationConditions with -D flags passed in OTHER_SWIFT_FLAGS:
+// LOGGING at the project level in Debug, the App target inherits it and defines CI and the already active MOCK_API in Debug.
+var pbxprojWithOtherSwiftFlags = newFixtureReplacer(
+	`				SWIFT_ACTIVE_COMPILATION_CONDITIONS = "DEBUG $(inherited)";
+`, `				OTHER_SWIFT_FLAGS = "-DLOGGING";
+				SWIFT_ACTIVE_COMPILATION_CONDITIONS = "DEBUG $(inherited)";
+`,
+	`				PRODUCT_NAME = "$(TARGET_NAME)";
+				SWIFT_ACTIVE_COMPILATION_CONDITIONS = (
+`, `				OTHER_SWIFT_FLAGS = (
+					"$(inherited)",
+					"-D",
+					CI,
+					"-DMOCK_API",
+				);
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				SWIFT_ACTIVE_COMPILATION_CONDITIONS = (
+`,
+).Replace(pbxprojWithCompilationConditions)
@@ -0,0 +1,78 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"strings"
+)
+
+const sourcesBuildPhaseType = "PBXSourcesBuildPhase"
+
+// FileCompilerFlags returns the per-file compiler flags (like -fno-objc-arc) of the source file
+// compiled by the target. The filePath is either the file element's path or a path ending with it.
+func (p XcodeProj) FileCompilerFlags(targetName, filePath string) (string, error) {
+	buildFile, err := p.sourceBuildFile(targetName, filePath)
+	if err != nil {
+		return "", err
+	}
+	return buildFile.CompilerFlags, nil
+}
+
+// SetFileCompilerFlags sets the per-file compiler flags of the source file compiled by the target.
+// Empty flags remove the file's COMPILER_FLAGS setting.
+func (p *XcodeProj) SetFileCompilerFlags(targetName, filePath, flags string) error {
+	buildFile, err := p.sourceBuildFile(targetName, filePath)
+	if err != nil {
+		return err
+	}
+
+	objects, err := p.RawProj.Object("objects")
+	if err != nil {
+		return err
+	}
+
+	rawBuildFile, err := objects.Object(buildFile.ID)
+	if err != nil {
+		return err
+	}
+
+	settings := buildFile.Settings
+	if settings == nil {
+		if flags == "" {
+			return nil
+		}
+		settings = map[string]interface{}{}
+	}
+
+	if flags == "" {
+		delete(settings, "COMPILER_FLAGS")
+	} else {
+		settings["COMPILER_FLAGS"] = flags
+	}
+
+	if len(settings) == 0 {
+		delete(rawBuildFile, "settings")
+	} else {
+		rawBuildFile["settings"] = map[string]interface{}(settings)
+	}
+
+	return nil
+}
+
+func (p XcodeProj) sourceBuildFile(targetName, filePath string) (BuildFileInfo, error) {
+	buildFiles, err := p.BuildFiles(targetName)
+	if err != nil {
+		return BuildFileInfo{}, err
+	}
+
+	for _, buildFile := range buildFiles {
+		if buildFile.PhaseType != sourcesBuildPhaseType || buildFile.Path == "" {
+			continue
+		}
+
+		if filePath == buildFile.Path || strings.HasSuffix(filePath, "/"+buildFile.Path) {
+			return buildFile, nil
+		}
+	}
+
+	return BuildFileInfo{}, fmt.Errorf("source file (%s) not found in target: %s", filePath, targetName)
+}
@@ -0,0 +1,45 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_FileCompilerFlags(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithBuildFiles))
+	require.NoError(t, err)
+
+	flags, err := project.FileCompilerFlags("App", "Legacy.m")
+	require.NoError(t, err)
+	require.Equal(t, "-fno-objc-arc", flags)
+
+	flags, err = project.FileCompilerFlags("App", "App/AppDelegate.m")
+	require.NoError(t, err)
+	require.Equal(t, "", flags)
+
+	_, err = project.FileCompilerFlags("App", "Kit.m")
+	require.Error(t, err)
+}
+
+func TestXcodeProj_SetFileCompilerFlags(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithBuildFiles, nil)
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+
+	require.NoError(t, project.SetFileCompilerFlags("App", "AppDelegate.m", "-w"))
+	require.NoError(t, project.SetFileCompilerFlags("App", "Legacy.m", ""))
+	require.NoError(t, project.Save())
+
+	project, err = Open(projectPth)
+	require.NoError(t, err)
+
+	flags, err := project.FileCompilerFlags("App", "AppDelegate.m")
+	require.NoError(t, err)
+	require.Equal(t, "-w", flags)
+
+	buildFiles, err := project.BuildFiles("App")
+	require.NoError(t, err)
+	require.Equal(t, "Legacy.m", buildFiles[1].Path)
+	require.Nil(t, buildFiles[1].Settings)
+}
@@ -0,0 +1,163 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// conditionalBuildSettingKeyRegexp matches build setting keys with optional conditions,
+// like EXCLUDED_ARCHS[sdk=iphonesimulator*] or OTHER_LDFLAGS[sdk=iphoneos*][arch=arm64].
+var conditionalBuildSettingKeyRegexp = regexp.MustCompile(`^([A-Za-z0-9_]+)((?:\[[^\]=]+=[^\]]*\])*)$`)
+
+var buildSettingConditionRegexp = regexp.MustCompile(`\[([^\]=]+)=([^\]]*)\]`)
+
+// parseConditionalBuildSettingKey splits the build setting key into the setting's name and its conditions.
+// **Example:** `EXCLUDED_ARCHS[sdk=iphonesimulator*]` **=>** `EXCLUDED_ARCHS`, `{sdk: iphonesimulator*}`
+func parseConditionalBuildSettingKey(key string) (string, map[string]string, bool) {
+	match := conditionalBuildSettingKeyRegexp.FindStringSubmatch(key)
+	if match == nil {
+		return "", nil, false
+	}
+
+	conditions := map[string]string{}
+	for _, condition := range buildSettingConditionRegexp.FindAllStringSubmatch(match[2], -1) {
+		conditions[condition[1]] = condition[2]
+	}
+	return match[1], conditions, true
+}
+
+// buildSettingConditionOrder is the order Xcode writes the well-known conditions in,
+// other conditions follow in alphabetical order.
+var buildSettingConditionOrder = []string{"sdk", "arch", "config"}
+
+// conditionalBuildSettingKey composes the build setting key with the given conditions.
+// **Example:** `EXCLUDED_ARCHS`, `{sdk: iphonesimulator*}` **=>** `EXCLUDED_ARCHS[sdk=iphonesimulator*]`
+func conditionalBuildSettingKey(name string, conditions map[string]string) string {
+	var conditionNames []string
+	for condition := range conditions {
+		conditionNames = append(conditionNames, condition)
+	}
+	sort.Slice(conditionNames, func(i, j int) bool {
+		iOrder, jOrder := buildSettingConditionIndex(conditionNames[i]), buildSettingConditionIndex(conditionNames[j])
+		if iOrder != jOrder {
+			return iOrder < jOrder
+		}
+		return conditionNames[i] < conditionNames[j]
+	})
+
+	key := name
+	for _, condition := range conditionNames {
+		key += "[" + condition + "=" + conditions[condition] + "]"
+	}
+	return key
+}
+
+func buildSettingConditionIndex(condition string) int {
+	for i, c := range buildSettingConditionOrder {
+		if c == condition {
+			return i
+		}
+	}
+	return len(buildSettingConditionOrder)
+}
+
+// SetConditionalBuildSetting sets the build setting variant which applies only when the conditions are met
+// (like sdk: iphonesimulator*, arch: arm64, config: Debug) in the target's given configuration,
+// or in all of the target's configurations if the configuration is empty.
+// **Example:** `EXCLUDED_ARCHS`, `{sdk: iphonesimulator*}` sets `EXCLUDED_ARCHS[sdk=iphonesimulator*]`
+// The project needs to be saved to persist the change.
+func (p XcodeProj) SetConditionalBuildSetting(target, configuration, name string, conditions map[string]string, value string) error {
+	key := conditionalBuildSettingKey(name, conditions)
+	if _, _, ok := parseConditionalBuildSettingKey(key); !ok {
+		return fmt.Errorf("invalid build setting key: %s", key)
+	}
+	return p.setTargetBuildSetting(target, configuration, key, value)
+}
+
+// conditionalBuildSettingValue returns the value of the named build setting which applies in the given context
+// (like sdk: iphonesimulator17.0, arch: arm64).
+// Of the keys whose conditions all match the context, the most specific one wins, like in Xcode.
+// Equally specific keys (like name[sdk=iphone*] and name[arch=arm64]) are ordered by the key, the first one wins.
+func conditionalBuildSettingValue(buildSettings serialized.Object, name string, context map[string]string) (interface{}, bool, error) {
+	var value interface{}
+	found, specificity := false, -1
+
+	for _, key := range sortedKeys(buildSettings) {
+		keyName, conditions, ok := parseConditionalBuildSettingKey(key)
+		if !ok || keyName != name || len(conditions) <= specificity {
+			continue
+		}
+
+		matches, err := buildSettingConditionsMatch(conditions, context)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid build setting key (%s): %s", key, err)
+		}
+		if matches {
+			value, found, specificity = buildSettings[key], true, len(conditions)
+		}
+	}
+
+	return value, found, nil
+}
+
+func buildSettingConditionsMatch(conditions, context map[string]string) (bool, error) {
+	for condition, pattern := range conditions {
+		value, ok := context[condition]
+		if !ok {
+			return false, nil
+		}
+
+		matches, err := path.Match(pattern, value)
+		if err != nil {
+			return false, err
+		}
+		if !matches {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// targetConfigurationLevels returns the target's build configuration with the given name,
+// followed by the project's build configuration with the same name, since target level settings override the project level ones.
+func (p XcodeProj) targetConfigurationLevels(target, configuration string) ([]BuildConfiguration, error) {
+	if configuration == "" {
+		return nil, fmt.Errorf("no configuration provided for target: %s", target)
+	}
+
+	buildConfigurations, err := p.targetBuildConfigurations(target, configuration)
+	if err != nil {
+		return nil, err
+	}
+	for _, buildConfiguration := range p.Proj.BuildConfigurationList.BuildConfigurations {
+		if buildConfiguration.Name == configuration {
+			buildConfigurations = append(buildConfigurations, buildConfiguration)
+		}
+	}
+	return buildConfigurations, nil
+}
+
+// targetConditionalBuildSettingList returns the list type build setting which applies to the target in the given context.
+// The target's configuration is looked up first, then the project's configuration with the same name.
+func (p XcodeProj) targetConditionalBuildSettingList(target, configuration, name string, context map[string]string) ([]string, error) {
+	buildConfigurations, err := p.targetConfigurationLevels(target, configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, buildConfiguration := range buildConfigurations {
+		value, found, err := conditionalBuildSettingValue(buildConfiguration.BuildSettings, name, context)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			return buildSettingList(serialized.Object{name: value}, name)
+		}
+	}
+
+	return nil, nil
+}
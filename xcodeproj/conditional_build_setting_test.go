@@ -0,0 +1,100 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseConditionalBuildSettingKey(t *testing.T) {
+	tests := []struct {
+		key            string
+		wantName       string
+		wantConditions map[string]string
+		wantOK         bool
+	}{
+		{key: "EXCLUDED_ARCHS", wantName: "EXCLUDED_ARCHS", wantConditions: map[string]string{}, wantOK: true},
+		{key: "EXCLUDED_ARCHS[sdk=iphonesimulator*]", wantName: "EXCLUDED_ARCHS", wantConditions: map[string]string{"sdk": "iphonesimulator*"}, wantOK: true},
+		{key: "OTHER_LDFLAGS[sdk=iphoneos*][arch=arm64]", wantName: "OTHER_LDFLAGS", wantConditions: map[string]string{"sdk": "iphoneos*", "arch": "arm64"}, wantOK: true},
+		{key: "EXCLUDED_ARCHS[sdk]", wantOK: false},
+		{key: "", wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			name, conditions, ok := parseConditionalBuildSettingKey(tt.key)
+			require.Equal(t, tt.wantOK, ok)
+			require.Equal(t, tt.wantName, name)
+			require.Equal(t, tt.wantConditions, conditions)
+		})
+	}
+}
+
+func Test_conditionalBuildSettingValue(t *testing.T) {
+	buildSettings := serialized.Object{
+		"EXCLUDED_ARCHS":                                    "i386",
+		"EXCLUDED_ARCHS[arch=arm64]":                        "x86_64",
+		"EXCLUDED_ARCHS[sdk=iphonesimulator*]":              "arm64",
+		"EXCLUDED_ARCHS[sdk=iphonesimulator*][arch=x86_64]": "",
+		"EXCLUDED_ARCHS_EXTRA":                              "armv7",
+	}
+
+	tests := []struct {
+		name      string
+		context   map[string]string
+		want      interface{}
+		wantFound bool
+	}{
+		{name: "unconditional", context: map[string]string{"sdk": "iphoneos17.0"}, want: "i386", wantFound: true},
+		{name: "no context", context: nil, want: "i386", wantFound: true},
+		{name: "sdk condition", context: map[string]string{"sdk": "iphonesimulator17.0"}, want: "arm64", wantFound: true},
+		{name: "most specific condition", context: map[string]string{"sdk": "iphonesimulator17.0", "arch": "x86_64"}, want: "", wantFound: true},
+		{name: "equally specific conditions", context: map[string]string{"sdk": "iphonesimulator17.0", "arch": "arm64"}, want: "x86_64", wantFound: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found, err := conditionalBuildSettingValue(buildSettings, "EXCLUDED_ARCHS", tt.context)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantFound, found)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	_, found, err := conditionalBuildSettingValue(serialized.Object{"EXCLUDED_ARCHS[sdk=iphoneos*]": "arm64"}, "EXCLUDED_ARCHS", map[string]string{"sdk": "macosx"})
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func Test_conditionalBuildSettingKey(t *testing.T) {
+	require.Equal(t, "EXCLUDED_ARCHS", conditionalBuildSettingKey("EXCLUDED_ARCHS", nil))
+	require.Equal(t, "EXCLUDED_ARCHS[sdk=iphonesimulator*]", conditionalBuildSettingKey("EXCLUDED_ARCHS", map[string]string{"sdk": "iphonesimulator*"}))
+	require.Equal(t, "OTHER_LDFLAGS[sdk=iphoneos*][arch=arm64][config=Debug][variant=normal]", conditionalBuildSettingKey("OTHER_LDFLAGS", map[string]string{
+		"variant": "normal",
+		"config":  "Debug",
+		"arch":    "arm64",
+		"sdk":     "iphoneos*",
+	}))
+}
+
+func TestXcodeProj_SetConditionalBuildSetting(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithBuildFiles, nil)
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+
+	require.NoError(t, project.SetConditionalBuildSetting("App", "Release", "CODE_SIGN_IDENTITY", map[string]string{"sdk": "iphoneos*"}, "iPhone Distribution"))
+	require.Error(t, project.SetConditionalBuildSetting("App", "Release", "CODE_SIGN_IDENTITY", map[string]string{"sdk]": "iphoneos*"}, "iPhone Distribution"))
+	require.Error(t, project.SetConditionalBuildSetting("App", "Missing", "CODE_SIGN_IDENTITY", nil, "iPhone Distribution"))
+	require.NoError(t, project.Save())
+
+	project, err = Open(projectPth)
+	require.NoError(t, err)
+
+	buildConfigurations, err := project.targetBuildConfigurations("App", "Release")
+	require.NoError(t, err)
+	require.Equal(t, "iPhone Distribution", buildConfigurations[0].BuildSettings["CODE_SIGN_IDENTITY[sdk=iphoneos*]"])
+
+	value, found, err := conditionalBuildSettingValue(buildConfigurations[0].BuildSettings, "CODE_SIGN_IDENTITY", map[string]string{"sdk": "iphoneos17.0"})
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "iPhone Distribution", value)
+}
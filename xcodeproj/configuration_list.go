@@ -46,6 +46,70 @@ func parseConfigurationList(id string, objects serialized.Object) (Configuration
 	}, nil
 }
 
+// targetDefaultConfigurationName returns the configuration xcodebuild uses for the target if none is given:
+// the default configuration of the target's configuration list, falling back to the project's default configuration
+// and then to the target's first configuration. An empty string is returned if the target has no configurations.
+func (p XcodeProj) targetDefaultConfigurationName(target Target) string {
+	for _, name := range []string{target.BuildConfigurationList.DefaultConfigurationName, p.Proj.BuildConfigurationList.DefaultConfigurationName} {
+		if name != "" && target.hasConfiguration(name) {
+			return name
+		}
+	}
+	if len(target.BuildConfigurationList.BuildConfigurations) > 0 {
+		return target.BuildConfigurationList.BuildConfigurations[0].Name
+	}
+	return ""
+}
+
+// ConfigurationListInfo is the metadata of a configuration list (XCConfigurationList).
+type ConfigurationListInfo struct {
+	ID                            string
+	DefaultConfigurationName      string
+	DefaultConfigurationIsVisible bool
+	// Configurations are the names of the list's build configurations, in the order of the list.
+	Configurations []string
+}
+
+// ConfigurationListInfo returns the metadata of the target's configuration list,
+// or of the project's configuration list if the targetName is empty.
+func (p XcodeProj) ConfigurationListInfo(targetName string) (ConfigurationListInfo, error) {
+	configurationList := p.Proj.BuildConfigurationList
+	if targetName != "" {
+		target, ok := p.Proj.TargetByName(targetName)
+		if !ok {
+			return ConfigurationListInfo{}, fmt.Errorf("target not found: %s", targetName)
+		}
+		configurationList = target.BuildConfigurationList
+	}
+
+	objects, err := p.RawProj.Object("objects")
+	if err != nil {
+		return ConfigurationListInfo{}, err
+	}
+
+	raw, err := objects.Object(configurationList.ID)
+	if err != nil {
+		return ConfigurationListInfo{}, err
+	}
+
+	isVisible, err := optionalString(raw, "defaultConfigurationIsVisible")
+	if err != nil {
+		return ConfigurationListInfo{}, err
+	}
+
+	var configurations []string
+	for _, buildConfiguration := range configurationList.BuildConfigurations {
+		configurations = append(configurations, buildConfiguration.Name)
+	}
+
+	return ConfigurationListInfo{
+		ID:                            configurationList.ID,
+		DefaultConfigurationName:      configurationList.DefaultConfigurationName,
+		DefaultConfigurationIsVisible: isVisible == "1",
+		Configurations:                configurations,
+	}, nil
+}
+
 // BuildConfigurationList ...
 func (p XcodeProj) BuildConfigurationList(targetID string) (serialized.Object, error) {
 	objects, err := p.RawProj.Object("objects")
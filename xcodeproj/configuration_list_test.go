@@ -3,6 +3,7 @@ package xcodeproj
 import (
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/bitrise-io/go-plist"
@@ -24,6 +25,38 @@ func TestParseConfigurationList(t *testing.T) {
 	require.Equal(t, expectedConfigurationList, pretty.Object(configurationList))
 }
 
+func TestXcodeProj_ConfigurationListInfo(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(strings.Replace(pbxprojWithBuildFiles, `				E2B0F0752C8B4A0000A1B2C3 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;`, `				E2B0F0752C8B4A0000A1B2C3 /* Release */,
+			);
+			defaultConfigurationIsVisible = 1;
+			defaultConfigurationName = Debug;`, 1)))
+	require.NoError(t, err)
+
+	info, err := project.ConfigurationListInfo("")
+	require.NoError(t, err)
+	require.Equal(t, ConfigurationListInfo{
+		ID:                            "E2B0F0602C8B4A0000A1B2C3",
+		DefaultConfigurationName:      "Release",
+		DefaultConfigurationIsVisible: false,
+		Configurations:                []string{"Debug", "Release"},
+	}, info)
+
+	info, err = project.ConfigurationListInfo("Kit")
+	require.NoError(t, err)
+	require.Equal(t, ConfigurationListInfo{
+		ID:                            "E2B0F0622C8B4A0000A1B2C3",
+		DefaultConfigurationName:      "Debug",
+		DefaultConfigurationIsVisible: true,
+		Configurations:                []string{"Debug", "Release"},
+	}, info)
+
+	_, err = project.ConfigurationListInfo("Missing")
+	require.Error(t, err)
+}
+
 const rawConfigurationList = `
 {
 	13E76E3A1F4AC90A0028096E /* Build configuration list for PBXNativeTarget "code-sign-test" */ = {
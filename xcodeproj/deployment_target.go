@@ -0,0 +1,80 @@
+package xcodeproj
+
+// deploymentTargetKeys maps the SDKROOT values to the build setting holding the platform's minimum OS version.
+var deploymentTargetKeys = map[string]string{
+	"iphoneos":  "IPHONEOS_DEPLOYMENT_TARGET",
+	"macosx":    "MACOSX_DEPLOYMENT_TARGET",
+	"appletvos": "TVOS_DEPLOYMENT_TARGET",
+	"watchos":   "WATCHOS_DEPLOYMENT_TARGET",
+	"xros":      "XROS_DEPLOYMENT_TARGET",
+}
+
+// deploymentTargetKeyOrder is the order the deployment target build settings are checked in,
+// if the SDKROOT does not determine the platform (like multiplatform targets).
+var deploymentTargetKeyOrder = []string{
+	"IPHONEOS_DEPLOYMENT_TARGET",
+	"MACOSX_DEPLOYMENT_TARGET",
+	"TVOS_DEPLOYMENT_TARGET",
+	"WATCHOS_DEPLOYMENT_TARGET",
+	"XROS_DEPLOYMENT_TARGET",
+}
+
+// TargetDeploymentTarget returns the minimum OS version (like IPHONEOS_DEPLOYMENT_TARGET) of the target's configuration
+// for the platform selected by the SDKROOT build setting, as set in the project file.
+// Target level settings override the project level ones; an empty version is returned if none is set.
+func (p XcodeProj) TargetDeploymentTarget(target, configuration string) (string, error) {
+	buildConfigurations, err := p.targetConfigurationLevels(target, configuration)
+	if err != nil {
+		return "", err
+	}
+
+	keys := deploymentTargetKeyOrder
+	if key, ok := deploymentTargetKeys[buildConfigurationLevelsString(buildConfigurations, "SDKROOT")]; ok {
+		keys = []string{key}
+	}
+
+	for _, key := range keys {
+		if version := buildConfigurationLevelsString(buildConfigurations, key); version != "" {
+			return version, nil
+		}
+	}
+	return "", nil
+}
+
+// DeploymentTargets returns the minimum OS version of each native target's configurations,
+// mapped by the target and the configuration name. Configurations without deployment target are omitted.
+func (p XcodeProj) DeploymentTargets() (map[string]map[string]string, error) {
+	deploymentTargets := map[string]map[string]string{}
+	for _, target := range p.Proj.Targets {
+		if target.Type != NativeTargetType {
+			continue
+		}
+
+		versions := map[string]string{}
+		for _, buildConfiguration := range target.BuildConfigurationList.BuildConfigurations {
+			version, err := p.TargetDeploymentTarget(target.Name, buildConfiguration.Name)
+			if err != nil {
+				return nil, err
+			}
+			if version != "" {
+				versions[buildConfiguration.Name] = version
+			}
+		}
+
+		if len(versions) > 0 {
+			deploymentTargets[target.Name] = versions
+		}
+	}
+	return deploymentTargets, nil
+}
+
+// buildConfigurationLevelsString returns the first non-empty string value of the build setting
+// in the build configurations, or an empty string if not set.
+func buildConfigurationLevelsString(buildConfigurations []BuildConfiguration, key string) string {
+	for _, buildConfiguration := range buildConfigurations {
+		if value, err := buildConfiguration.BuildSettings.String(key); err == nil && value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,48 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_DeploymentTargets(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithDeploymentTargets))
+	require.NoError(t, err)
+
+	deploymentTargets, err := project.DeploymentTargets()
+	require.NoError(t, err)
+	require.Equal(t, map[string]map[string]string{
+		"App": {"Debug": "15.0", "Release": "16.0"},
+		"Kit": {"Debug": "13.0", "Release": "15.0"},
+	}, deploymentTargets)
+
+	_, err = project.TargetDeploymentTarget("App", "Missing")
+	require.Error(t, err)
+}
+
+// pbxprojWithDeploymentTargets raises the App target's Release deployment target
+// and turns the Kit target's Debug configuration into a macOS one.
+var pbxprojWithDeploymentTargets = newFixtureReplacer(
+	`				PRODUCT_NAME = "$(TARGET_NAME)";
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Release;`, `				IPHONEOS_DEPLOYMENT_TARGET = 16.0;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Release;`,
+	`				INFOPLIST_FILE = Kit/Info.plist;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.Kit;
+				PRODUCT_NAME = "$(TARGET_NAME:c99extidentifier)";
+				SKIP_INSTALL = YES;
+			};
+			name = Debug;`, `				INFOPLIST_FILE = Kit/Info.plist;
+				MACOSX_DEPLOYMENT_TARGET = 13.0;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.Kit;
+				PRODUCT_NAME = "$(TARGET_NAME:c99extidentifier)";
+				SDKROOT = macosx;
+				SKIP_INSTALL = YES;
+			};
+			name = Debug;`,
+).Replace(pbxprojWithBuildFiles)
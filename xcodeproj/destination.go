@@ -0,0 +1,102 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// destinationPlatforms maps the SUPPORTED_PLATFORMS values to the xcodebuild destination platforms.
+var destinationPlatforms = map[string]string{
+	"iphoneos":         "iOS",
+	"iphonesimulator":  "iOS Simulator",
+	"appletvos":        "tvOS",
+	"appletvsimulator": "tvOS Simulator",
+	"watchos":          "watchOS",
+	"watchsimulator":   "watchOS Simulator",
+	"xros":             "visionOS",
+	"xrsimulator":      "visionOS Simulator",
+	"macosx":           "macOS",
+}
+
+// Destination is a platform the target can be built for and run on.
+type Destination struct {
+	// Platform is the xcodebuild destination platform, like iOS or iOS Simulator.
+	Platform  string
+	Simulator bool
+	// MinimumOS is the deployment target of the platform, empty if not set.
+	MinimumOS string
+}
+
+// String returns the generic xcodebuild destination of the platform, like generic/platform=iOS Simulator.
+func (d Destination) String() string {
+	return "generic/platform=" + d.Platform
+}
+
+// Device returns the xcodebuild destination of the named device (or simulator) of the platform,
+// like platform=iOS Simulator,name=iPhone 15.
+func (d Destination) Device(name string) string {
+	return fmt.Sprintf("platform=%s,name=%s", d.Platform, name)
+}
+
+// TargetRunDestinations returns the destinations the target's configuration can run on,
+// based on the SUPPORTED_PLATFORMS (or SDKROOT), the TARGETED_DEVICE_FAMILY and the deployment target build settings.
+// The destinations are in the order of the SUPPORTED_PLATFORMS. Simulator destinations are omitted
+// if the target does not support simulators (see TargetSupportsSimulator).
+// The project does not know the available devices, callers pick one of the platform (running at least MinimumOS)
+// for a concrete destination, see Destination.Device.
+func (p XcodeProj) TargetRunDestinations(target, configuration string) ([]Destination, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return runDestinations(buildSettings)
+}
+
+func runDestinations(buildSettings serialized.Object) ([]Destination, error) {
+	platforms, err := supportedPlatforms(buildSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	simulator, err := supportsSimulator(buildSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	deviceSDKs := map[string]string{}
+	for deviceSDK, simulatorSDK := range simulatorSDKs {
+		deviceSDKs[simulatorSDK] = deviceSDK
+	}
+
+	var destinations []Destination
+	for _, platform := range platforms {
+		destinationPlatform, ok := destinationPlatforms[platform]
+		if !ok {
+			continue
+		}
+
+		deviceSDK, isSimulator := deviceSDKs[platform]
+		if isSimulator && !simulator {
+			continue
+		}
+		if !isSimulator {
+			deviceSDK = platform
+		}
+
+		minimumOS, err := buildSettings.String(deploymentTargetKeys[deviceSDK])
+		if err != nil && !serialized.IsKeyNotFoundError(err) {
+			return nil, err
+		}
+
+		destinations = append(destinations, Destination{
+			Platform:  destinationPlatform,
+			Simulator: isSimulator,
+			MinimumOS: strings.TrimSpace(minimumOS),
+		})
+	}
+
+	return destinations, nil
+}
@@ -0,0 +1,74 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_runDestinations(t *testing.T) {
+	tests := []struct {
+		name          string
+		buildSettings serialized.Object
+		want          []Destination
+	}{
+		{
+			name: "iOS target",
+			buildSettings: serialized.Object{
+				"SUPPORTED_PLATFORMS":        "iphonesimulator iphoneos",
+				"TARGETED_DEVICE_FAMILY":     "1,2",
+				"IPHONEOS_DEPLOYMENT_TARGET": "15.0",
+			},
+			want: []Destination{
+				{Platform: "iOS Simulator", Simulator: true, MinimumOS: "15.0"},
+				{Platform: "iOS", MinimumOS: "15.0"},
+			},
+		},
+		{
+			name: "tvOS target without SUPPORTED_PLATFORMS",
+			buildSettings: serialized.Object{
+				"SDKROOT":                "appletvos",
+				"TARGETED_DEVICE_FAMILY": "3",
+				"TVOS_DEPLOYMENT_TARGET": "17.0",
+			},
+			want: []Destination{
+				{Platform: "tvOS", MinimumOS: "17.0"},
+				{Platform: "tvOS Simulator", Simulator: true, MinimumOS: "17.0"},
+			},
+		},
+		{
+			name: "device only iOS target",
+			buildSettings: serialized.Object{
+				"SUPPORTED_PLATFORMS":    "iphoneos",
+				"TARGETED_DEVICE_FAMILY": "1",
+			},
+			want: []Destination{
+				{Platform: "iOS"},
+			},
+		},
+		{
+			name: "macOS target",
+			buildSettings: serialized.Object{
+				"SUPPORTED_PLATFORMS":      "macosx",
+				"MACOSX_DEPLOYMENT_TARGET": "13.0",
+			},
+			want: []Destination{
+				{Platform: "macOS", MinimumOS: "13.0"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := runDestinations(tt.buildSettings)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDestination(t *testing.T) {
+	destination := Destination{Platform: "iOS Simulator", Simulator: true, MinimumOS: "15.0"}
+	require.Equal(t, "generic/platform=iOS Simulator", destination.String())
+	require.Equal(t, "platform=iOS Simulator,name=iPhone 15", destination.Device("iPhone 15"))
+}
@@ -0,0 +1,87 @@
+package xcodeproj
+
+import (
+	"fmt"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/bitrise-io/xcode-project/xcscheme"
+)
+
+// SchemeTargetDevelopmentTeam returns the DEVELOPMENT_TEAM of the app target built by the scheme (see SetSchemeTargetDevelopmentTeam)
+// in the scheme's archive configuration, as set in the project file (at the target or the project level).
+// An empty string is returned if no development team is set.
+func (p XcodeProj) SchemeTargetDevelopmentTeam(schemeName string) (string, error) {
+	scheme, target, err := p.schemeAppTarget(schemeName)
+	if err != nil {
+		return "", err
+	}
+
+	configuration := scheme.ArchiveAction.BuildConfiguration
+	if configuration == "" {
+		return "", fmt.Errorf("no archive configuration set in scheme: %s", schemeName)
+	}
+
+	buildConfigurations, err := p.targetConfigurationLevels(target.Name, configuration)
+	if err != nil {
+		return "", err
+	}
+	return buildConfigurationLevelsString(buildConfigurations, "DEVELOPMENT_TEAM"), nil
+}
+
+// SetSchemeTargetDevelopmentTeam sets the development team of the app target the scheme builds (its archivable app build action entry),
+// the target's DEVELOPMENT_TEAM build setting (including the sdk specific ones, like DEVELOPMENT_TEAM[sdk=iphoneos*])
+// in all of its build configurations and the DevelopmentTeam of its TargetAttributes, if the project has them.
+// The project needs to be saved to persist the change.
+func (p XcodeProj) SetSchemeTargetDevelopmentTeam(schemeName, teamID string) error {
+	_, target, err := p.schemeAppTarget(schemeName)
+	if err != nil {
+		return err
+	}
+
+	buildConfigurations, err := p.targetBuildConfigurations(target.Name, "")
+	if err != nil {
+		return err
+	}
+	for _, buildConfiguration := range buildConfigurations {
+		writeAttributeForAllSDKs(buildConfiguration.BuildSettings, "DEVELOPMENT_TEAM", teamID)
+	}
+
+	targetAttributes, err := p.TargetAttributes()
+	if err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+
+	targetAttribute, err := targetAttributes.Object(target.ID)
+	if err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	targetAttribute["DevelopmentTeam"] = teamID
+
+	return nil
+}
+
+// schemeAppTarget returns the scheme and the project's app target built by the scheme for archiving.
+func (p XcodeProj) schemeAppTarget(schemeName string) (*xcscheme.Scheme, Target, error) {
+	scheme, _, err := p.Scheme(schemeName)
+	if err != nil {
+		return nil, Target{}, err
+	}
+
+	entry, ok := scheme.AppBuildActionEntry()
+	if !ok {
+		return nil, Target{}, fmt.Errorf("no app target found in scheme: %s", schemeName)
+	}
+
+	target, ok := p.Proj.Target(entry.BuildableReference.BlueprintIdentifier)
+	if !ok {
+		return nil, Target{}, fmt.Errorf("app target (%s) of scheme (%s) not found in project (%s)", entry.BuildableReference.BlueprintName, schemeName, p.Name)
+	}
+
+	return scheme, target, nil
+}
@@ -0,0 +1,75 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_SetSchemeTargetDevelopmentTeam(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithDevelopmentTeam, map[string]string{
+		"xcshareddata/xcschemes/App.xcscheme": appSchemeContent,
+	})
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+
+	team, err := project.SchemeTargetDevelopmentTeam("App")
+	require.NoError(t, err)
+	require.Equal(t, "72SA8V3WYL", team)
+
+	require.NoError(t, project.SetSchemeTargetDevelopmentTeam("App", "ABCDE12345"))
+	require.NoError(t, project.Save())
+
+	project, err = Open(projectPth)
+	require.NoError(t, err)
+
+	team, err = project.SchemeTargetDevelopmentTeam("App")
+	require.NoError(t, err)
+	require.Equal(t, "ABCDE12345", team)
+
+	for _, configuration := range []string{"Debug", "Release"} {
+		buildConfigurations, err := project.targetBuildConfigurations("App", configuration)
+		require.NoError(t, err)
+		require.Equal(t, "ABCDE12345", buildConfigurations[0].BuildSettings["DEVELOPMENT_TEAM"], configuration)
+		require.Equal(t, "ABCDE12345", buildConfigurations[0].BuildSettings["DEVELOPMENT_TEAM[sdk=iphoneos*]"], configuration)
+	}
+
+	kitBuildConfigurations, err := project.targetBuildConfigurations("Kit", "Release")
+	require.NoError(t, err)
+	require.NotContains(t, kitBuildConfigurations[0].BuildSettings, "DEVELOPMENT_TEAM")
+
+	targetAttributes, err := project.TargetAttributes()
+	require.NoError(t, err)
+	appAttributes, err := targetAttributes.Object("E2B0F0402C8B4A0000A1B2C3")
+	require.NoError(t, err)
+	require.Equal(t, "ABCDE12345", appAttributes["DevelopmentTeam"])
+
+	err = project.SetSchemeTargetDevelopmentTeam("Missing", "ABCDE12345")
+	require.Error(t, err)
+}
+
+// pbxprojWithDevelopmentTeam sets the development team of the App target in its build settings and target attributes.
+var pbxprojWithDevelopmentTeam = newFixtureReplacer(
+	`				LastUpgradeCheck = 1500;
+`, `				LastUpgradeCheck = 1500;
+				TargetAttributes = {
+					E2B0F0402C8B4A0000A1B2C3 = {
+						CreatedOnToolsVersion = 15.0;
+						DevelopmentTeam = 72SA8V3WYL;
+					};
+				};
+`,
+	`				INFOPLIST_FILE = App/Info.plist;
+`, `				DEVELOPMENT_TEAM = 72SA8V3WYL;
+				"DEVELOPMENT_TEAM[sdk=iphoneos*]" = 72SA8V3WYL;
+				INFOPLIST_FILE = App/Info.plist;
+`,
+).Replace(pbxprojWithBuildFiles)
+
+// appSchemeContent builds and archives the App target of pbxprojWithBuildFiles.
+var appSchemeContent = newFixtureReplacer(
+	"13BD62FD256BE6D000F72361", "E2B0F0402C8B4A0000A1B2C3",
+	"Target.app", "App.app",
+	`BlueprintName = "Target"`, `BlueprintName = "App"`,
+	"container:Target.xcodeproj", "container:App.xcodeproj",
+).Replace(targetSchemeContent)
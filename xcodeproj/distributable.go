@@ -0,0 +1,75 @@
+package xcodeproj
+
+// DistributableApps returns the app targets which can be uploaded to TestFlight and the App Store in the given configuration,
+// in the project's target order: the application targets with SKIP_INSTALL disabled which are not dedicated test hosts.
+// An app hosting tests (see HostedTestTargets) is a dedicated test host if the project's schemes archive other apps, but not this one.
+// If none of the schemes archive an app (like when the schemes are not shared, or live in a workspace),
+// or the project is opened by OpenFS, the apps hosting tests are kept.
+// Targets missing the configuration are omitted.
+func (p XcodeProj) DistributableApps(configuration string) ([]Target, error) {
+	var archivedTargetIDs map[string]bool
+	archivedTargetIDsRead := false
+
+	var apps []Target
+	for _, target := range p.Proj.Targets {
+		if !target.IsInstallableProduct() || target.ProductType == appClipProductType {
+			continue
+		}
+		if !target.hasConfiguration(configuration) {
+			continue
+		}
+
+		buildSettings, err := p.TargetBuildSettings(target.Name, configuration)
+		if err != nil {
+			return nil, err
+		}
+
+		skipInstall, _, err := boolBuildSetting(buildSettings, "SKIP_INSTALL")
+		if err != nil {
+			return nil, err
+		}
+		if skipInstall {
+			continue
+		}
+
+		hostedTestTargets, err := p.HostedTestTargets(target.Name)
+		if err != nil {
+			return nil, err
+		}
+		if len(hostedTestTargets) > 0 {
+			if !archivedTargetIDsRead {
+				if archivedTargetIDs, err = p.archivedTargetIDs(); err != nil {
+					return nil, err
+				}
+				archivedTargetIDsRead = true
+			}
+			if len(archivedTargetIDs) > 0 && !archivedTargetIDs[target.ID] {
+				continue
+			}
+		}
+
+		apps = append(apps, target)
+	}
+	return apps, nil
+}
+
+// archivedTargetIDs returns the IDs of the app targets archived by the project's schemes.
+// The schemes of a project opened by OpenFS are not available, so no IDs are returned.
+func (p XcodeProj) archivedTargetIDs() (map[string]bool, error) {
+	if p.fsBacked {
+		return nil, nil
+	}
+
+	schemes, err := p.Schemes()
+	if err != nil {
+		return nil, err
+	}
+
+	targetIDs := map[string]bool{}
+	for _, scheme := range schemes {
+		if entry, ok := scheme.AppBuildActionEntry(); ok {
+			targetIDs[entry.BuildableReference.BlueprintIdentifier] = true
+		}
+	}
+	return targetIDs, nil
+}
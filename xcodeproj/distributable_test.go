@@ -0,0 +1,249 @@
+package xcodeproj
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_DistributableApps(t *testing.T) {
+	appScheme := map[string]string{
+		"xcshareddata/xcschemes/App.xcscheme": appSchemeContent,
+	}
+
+	tests := []struct {
+		name    string
+		pbxproj string
+		files   map[string]string
+		want    []string
+	}{
+		{
+			name:    "app, internal tool and framework",
+			pbxproj: pbxprojWithInternalTool,
+			files:   appScheme,
+			want:    []string{"App"},
+		},
+		{
+			name:    "dedicated test host and app clip",
+			pbxproj: pbxprojWithDedicatedTestHost,
+			files:   appScheme,
+			want:    []string{"App"},
+		},
+		{
+			name:    "app hosting its tests without schemes",
+			pbxproj: pbxprojWithTestTargets,
+			want:    []string{"App"},
+		},
+		{
+			name:    "test hosts without schemes archiving an app",
+			pbxproj: pbxprojWithDedicatedTestHost,
+			want:    []string{"App", "TestHost"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectPth := createTmpProject(t, "App.xcodeproj", tt.pbxproj, tt.files)
+			project, err := Open(projectPth)
+			require.NoError(t, err)
+			project.SetBuildSettingsProvider(rawBuildSettingsProvider(project))
+
+			apps, err := project.DistributableApps("Release")
+			require.NoError(t, err)
+
+			var names []string
+			for _, app := range apps {
+				names = append(names, app.Name)
+			}
+			require.Equal(t, tt.want, names)
+
+			apps, err = project.DistributableApps("Missing")
+			require.NoError(t, err)
+			require.Empty(t, apps)
+		})
+	}
+}
+
+func TestXcodeProj_DistributableApps_OpenFS(t *testing.T) {
+	project, err := OpenFS(fstest.MapFS{
+		"App.xcodeproj/project.pbxproj":                     {Data: []byte(pbxprojWithTestTargets)},
+		"App.xcodeproj/xcshareddata/xcschemes/App.xcscheme": {Data: []byte(appSchemeContent)},
+	}, "App.xcodeproj")
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(project))
+
+	apps, err := project.DistributableApps("Release")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(apps))
+	require.Equal(t, "App", apps[0].Name)
+}
+
+// pbxprojWithInternalTool extends pbxprojWithTestTargets with an internal tool app target (Tool),
+// which is not installed (SKIP_INSTALL = YES), next to the App and the Kit framework targets.
+var pbxprojWithInternalTool = newFixtureReplacer(
+	`/* End PBXFileReference section */`,
+	`		E2B0F00E2C8B4A0000A1B2C3 /* Tool.app */ = {isa = PBXFileReference; explicitFileType = wrapper.application; includeInIndex = 0; path = Tool.app; sourceTree = BUILT_PRODUCTS_DIR; };
+/* End PBXFileReference section */`,
+
+	`/* End PBXNativeTarget section */`,
+	`		E2B0F0442C8B4A0000A1B2C3 /* Tool */ = {
+			isa = PBXNativeTarget;
+			buildConfigurationList = E2B0F0652C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "Tool" */;
+			buildPhases = (
+			);
+			buildRules = (
+			);
+			dependencies = (
+			);
+			name = Tool;
+			productName = Tool;
+			productReference = E2B0F00E2C8B4A0000A1B2C3 /* Tool.app */;
+			productType = "com.apple.product-type.application";
+		};
+/* End PBXNativeTarget section */`,
+
+	`				E2B0F0432C8B4A0000A1B2C3 /* AppUITests */,
+			);`,
+	`				E2B0F0432C8B4A0000A1B2C3 /* AppUITests */,
+				E2B0F0442C8B4A0000A1B2C3 /* Tool */,
+			);`,
+
+	`/* End XCBuildConfiguration section */`,
+	`		E2B0F07A2C8B4A0000A1B2C3 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.Tool;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				SKIP_INSTALL = YES;
+			};
+			name = Debug;
+		};
+		E2B0F07B2C8B4A0000A1B2C3 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.Tool;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				SKIP_INSTALL = YES;
+			};
+			name = Release;
+		};
+/* End XCBuildConfiguration section */`,
+
+	`/* End XCConfigurationList section */`,
+	`		E2B0F0652C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "Tool" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				E2B0F07A2C8B4A0000A1B2C3 /* Debug */,
+				E2B0F07B2C8B4A0000A1B2C3 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+/* End XCConfigurationList section */`,
+).Replace(pbxprojWithTestTargets)
+
+// pbxprojWithDedicatedTestHost extends pbxprojWithTestTargets with a TestHost app target hosting the AppTests unit tests,
+// which is installed but not archived by any scheme, and an App Clip target (Clip).
+var pbxprojWithDedicatedTestHost = newFixtureReplacer(
+	`/* End PBXFileReference section */`,
+	`		E2B0F0D02C8B4A0000A1B2C3 /* TestHost.app */ = {isa = PBXFileReference; explicitFileType = wrapper.application; includeInIndex = 0; path = TestHost.app; sourceTree = BUILT_PRODUCTS_DIR; };
+		E2B0F0D12C8B4A0000A1B2C3 /* Clip.app */ = {isa = PBXFileReference; explicitFileType = wrapper.application; includeInIndex = 0; path = Clip.app; sourceTree = BUILT_PRODUCTS_DIR; };
+/* End PBXFileReference section */`,
+
+	`/* End PBXNativeTarget section */`,
+	`		E2B0F0D22C8B4A0000A1B2C3 /* TestHost */ = {
+			isa = PBXNativeTarget;
+			buildConfigurationList = E2B0F0D42C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "TestHost" */;
+			buildPhases = (
+			);
+			buildRules = (
+			);
+			dependencies = (
+			);
+			name = TestHost;
+			productName = TestHost;
+			productReference = E2B0F0D02C8B4A0000A1B2C3 /* TestHost.app */;
+			productType = "com.apple.product-type.application";
+		};
+		E2B0F0D32C8B4A0000A1B2C3 /* Clip */ = {
+			isa = PBXNativeTarget;
+			buildConfigurationList = E2B0F0D52C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "Clip" */;
+			buildPhases = (
+			);
+			buildRules = (
+			);
+			dependencies = (
+			);
+			name = Clip;
+			productName = Clip;
+			productReference = E2B0F0D12C8B4A0000A1B2C3 /* Clip.app */;
+			productType = "com.apple.product-type.application.on-demand-install-capable";
+		};
+/* End PBXNativeTarget section */`,
+
+	`				E2B0F0432C8B4A0000A1B2C3 /* AppUITests */,
+			);`,
+	`				E2B0F0432C8B4A0000A1B2C3 /* AppUITests */,
+				E2B0F0D22C8B4A0000A1B2C3 /* TestHost */,
+				E2B0F0D32C8B4A0000A1B2C3 /* Clip */,
+			);`,
+
+	`TEST_HOST = "$(BUILT_PRODUCTS_DIR)/App.app/$(BUNDLE_EXECUTABLE_FOLDER_PATH)/App";`,
+	`TEST_HOST = "$(BUILT_PRODUCTS_DIR)/TestHost.app/$(BUNDLE_EXECUTABLE_FOLDER_PATH)/TestHost";`,
+
+	`/* End XCBuildConfiguration section */`,
+	`		E2B0F0D62C8B4A0000A1B2C3 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.TestHost;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+			};
+			name = Debug;
+		};
+		E2B0F0D72C8B4A0000A1B2C3 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.TestHost;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+			};
+			name = Release;
+		};
+		E2B0F0D82C8B4A0000A1B2C3 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.App.Clip;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+			};
+			name = Debug;
+		};
+		E2B0F0D92C8B4A0000A1B2C3 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.App.Clip;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+			};
+			name = Release;
+		};
+/* End XCBuildConfiguration section */`,
+
+	`/* End XCConfigurationList section */`,
+	`		E2B0F0D42C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "TestHost" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				E2B0F0D62C8B4A0000A1B2C3 /* Debug */,
+				E2B0F0D72C8B4A0000A1B2C3 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+		E2B0F0D52C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "Clip" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				E2B0F0D82C8B4A0000A1B2C3 /* Debug */,
+				E2B0F0D92C8B4A0000A1B2C3 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+/* End XCConfigurationList section */`,
+).Replace(pbxprojWithTestTargets)
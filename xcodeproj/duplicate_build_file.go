@@ -0,0 +1,99 @@
+package xcodeproj
+
+import (
+	"fmt"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// DuplicateBuildFiles returns the file references (or Swift package product references) which are added
+// more than once to the same build phase of the target, typically as a result of a merge conflict resolution.
+// Each reference is listed once, in the order of the build phases.
+func (p XcodeProj) DuplicateBuildFiles(targetName string) ([]string, error) {
+	buildFiles, err := p.BuildFiles(targetName)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	reported := map[string]bool{}
+	var duplicates []string
+	for _, buildFile := range buildFiles {
+		ref := buildFileRef(buildFile)
+		key := buildFile.PhaseID + "/" + ref
+		if !seen[key] {
+			seen[key] = true
+			continue
+		}
+
+		if !reported[ref] {
+			reported[ref] = true
+			duplicates = append(duplicates, ref)
+		}
+	}
+	return duplicates, nil
+}
+
+// DeduplicateBuildFiles removes the repeated build files of the target's build phases,
+// keeping the first build file of each file reference in a build phase.
+// The removed PBXBuildFile elements are left in the project unreferenced.
+// The project needs to be saved to persist the change.
+func (p XcodeProj) DeduplicateBuildFiles(targetName string) error {
+	target, ok := p.Proj.TargetByName(targetName)
+	if !ok {
+		return fmt.Errorf("target not found: %s", targetName)
+	}
+
+	objects, err := p.RawProj.Object("objects")
+	if err != nil {
+		return err
+	}
+
+	for _, phaseID := range target.buildPhaseIDs {
+		phase, err := objects.Object(phaseID)
+		if err != nil {
+			return err
+		}
+
+		fileIDs, err := phase.StringSlice("files")
+		if err != nil {
+			if serialized.IsKeyNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+
+		seen := map[string]bool{}
+		var files []interface{}
+		for _, fileID := range fileIDs {
+			buildFile, err := parseBuildFileInfo(fileID, objects)
+			if err != nil {
+				return fmt.Errorf("failed to parse build file (%s) of build phase (%s): %s", fileID, phaseID, err)
+			}
+
+			ref := buildFileRef(buildFile)
+			if seen[ref] {
+				continue
+			}
+			seen[ref] = true
+			files = append(files, fileID)
+		}
+
+		if len(files) != len(fileIDs) {
+			phase["files"] = files
+		}
+	}
+	return nil
+}
+
+// buildFileRef returns the id of the element referenced by the build file:
+// the file reference, the Swift package product or the build file itself if it references neither.
+func buildFileRef(buildFile BuildFileInfo) string {
+	if buildFile.FileRef != "" {
+		return buildFile.FileRef
+	}
+	if buildFile.ProductRef != "" {
+		return buildFile.ProductRef
+	}
+	return buildFile.ID
+}
@@ -0,0 +1,58 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_DeduplicateBuildFiles(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithDuplicateBuildFiles, nil)
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+
+	duplicates, err := project.DuplicateBuildFiles("App")
+	require.NoError(t, err)
+	require.Equal(t, []string{"E2B0F0032C8B4A0000A1B2C3", "E2B0F0042C8B4A0000A1B2C3"}, duplicates)
+
+	duplicates, err = project.DuplicateBuildFiles("Kit")
+	require.NoError(t, err)
+	require.Empty(t, duplicates)
+
+	require.NoError(t, project.DeduplicateBuildFiles("App"))
+	require.Error(t, project.DeduplicateBuildFiles("Missing"))
+	require.NoError(t, project.Save())
+
+	project, err = Open(projectPth)
+	require.NoError(t, err)
+
+	duplicates, err = project.DuplicateBuildFiles("App")
+	require.NoError(t, err)
+	require.Empty(t, duplicates)
+
+	buildFiles, err := project.BuildFiles("App")
+	require.NoError(t, err)
+	var sources []string
+	for _, buildFile := range buildFiles {
+		if buildFile.PhaseType == sourcesBuildPhaseType {
+			sources = append(sources, buildFile.ID)
+		}
+	}
+	require.Equal(t, []string{"E2B0F0112C8B4A0000A1B2C3", "E2B0F0122C8B4A0000A1B2C3"}, sources)
+}
+
+// pbxprojWithDuplicateBuildFiles adds AppDelegate.m to the App target's Sources phase a second time with a new build file,
+// and lists the Legacy.m build file twice.
+var pbxprojWithDuplicateBuildFiles = newFixtureReplacer(
+	`		E2B0F0112C8B4A0000A1B2C3 /* AppDelegate.m in Sources */ = {isa = PBXBuildFile; fileRef = E2B0F0032C8B4A0000A1B2C3 /* AppDelegate.m */; };
+`, `		E2B0F0112C8B4A0000A1B2C3 /* AppDelegate.m in Sources */ = {isa = PBXBuildFile; fileRef = E2B0F0032C8B4A0000A1B2C3 /* AppDelegate.m */; };
+		E2B0F01F2C8B4A0000A1B2C3 /* AppDelegate.m in Sources */ = {isa = PBXBuildFile; fileRef = E2B0F0032C8B4A0000A1B2C3 /* AppDelegate.m */; };
+`,
+	`				E2B0F0112C8B4A0000A1B2C3 /* AppDelegate.m in Sources */,
+				E2B0F0122C8B4A0000A1B2C3 /* Legacy.m in Sources */,
+`, `				E2B0F0112C8B4A0000A1B2C3 /* AppDelegate.m in Sources */,
+				E2B0F0122C8B4A0000A1B2C3 /* Legacy.m in Sources */,
+				E2B0F01F2C8B4A0000A1B2C3 /* AppDelegate.m in Sources */,
+				E2B0F0122C8B4A0000A1B2C3 /* Legacy.m in Sources */,
+`,
+).Replace(pbxprojWithBuildFiles)
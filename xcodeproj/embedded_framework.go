@@ -0,0 +1,69 @@
+package xcodeproj
+
+import (
+	"path"
+	"strings"
+)
+
+const (
+	copyFilesBuildPhaseType = "PBXCopyFilesBuildPhase"
+	// frameworksDestinationSubfolderSpec is the dstSubfolderSpec of the copy files build phases
+	// copying into the product's Frameworks directory, like the Embed Frameworks phase.
+	frameworksDestinationSubfolderSpec = "10"
+)
+
+// TargetsEmbeddingFramework returns the names of the targets embedding the framework, in the project's target order.
+// A target embeds the framework if one of its copy files build phases copies the framework into the Frameworks destination.
+// The frameworkName is either the bare name (Kit) or the bundle name (Kit.framework or Kit.xcframework).
+func (p XcodeProj) TargetsEmbeddingFramework(frameworkName string) ([]string, error) {
+	objects, err := p.RawProj.Object("objects")
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []string
+	for _, target := range p.Proj.Targets {
+		buildFiles, err := p.BuildFiles(target.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, buildFile := range buildFiles {
+			if buildFile.PhaseType != copyFilesBuildPhaseType || !isFramework(buildFile.Path, frameworkName) {
+				continue
+			}
+
+			phase, err := objects.Object(buildFile.PhaseID)
+			if err != nil {
+				return nil, err
+			}
+
+			dstSubfolderSpec, err := optionalString(phase, "dstSubfolderSpec")
+			if err != nil {
+				return nil, err
+			}
+
+			if dstSubfolderSpec == frameworksDestinationSubfolderSpec {
+				targets = append(targets, target.Name)
+				break
+			}
+		}
+	}
+
+	return targets, nil
+}
+
+// isFramework reports whether the file path refers to the framework, given by its bare or bundle name.
+func isFramework(filePath, frameworkName string) bool {
+	base := path.Base(filePath)
+	if base == frameworkName {
+		return true
+	}
+
+	for _, ext := range []string{".framework", ".xcframework"} {
+		if strings.HasSuffix(base, ext) && strings.TrimSuffix(base, ext) == frameworkName {
+			return true
+		}
+	}
+	return false
+}
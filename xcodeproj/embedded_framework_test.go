@@ -0,0 +1,79 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_TargetsEmbeddingFramework(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithEmbeddedFrameworks))
+	require.NoError(t, err)
+
+	for _, frameworkName := range []string{"Kit", "Kit.framework"} {
+		targets, err := project.TargetsEmbeddingFramework(frameworkName)
+		require.NoError(t, err)
+		require.Equal(t, []string{"App", "AppUITests"}, targets)
+	}
+
+	targets, err := project.TargetsEmbeddingFramework("Missing")
+	require.NoError(t, err)
+	require.Empty(t, targets)
+}
+
+func Test_isFramework(t *testing.T) {
+	require.True(t, isFramework("Kit.framework", "Kit"))
+	require.True(t, isFramework("Vendor/Kit.xcframework", "Kit"))
+	require.True(t, isFramework("Kit.xcframework", "Kit.xcframework"))
+	require.False(t, isFramework("KitExtras.framework", "Kit"))
+	require.False(t, isFramework("Kit.framework", "Kit.xcframework"))
+}
+
+// pbxprojWithEmbeddedFrameworks extends pbxprojWithTestTargets with the AppUITests target embedding Kit.framework,
+// the same way the App target does, and the AppTests target copying it into its resources, which is not embedding.
+var pbxprojWithEmbeddedFrameworks = newFixtureReplacer(
+	`/* End PBXBuildFile section */`,
+	`		E2B0F0192C8B4A0000A1B2C3 /* Kit.framework in Embed Frameworks */ = {isa = PBXBuildFile; fileRef = E2B0F0022C8B4A0000A1B2C3 /* Kit.framework */; settings = {ATTRIBUTES = (CodeSignOnCopy, RemoveHeadersOnCopy, ); }; };
+		E2B0F01A2C8B4A0000A1B2C3 /* Kit.framework in CopyFiles */ = {isa = PBXBuildFile; fileRef = E2B0F0022C8B4A0000A1B2C3 /* Kit.framework */; };
+/* End PBXBuildFile section */`,
+
+	`/* End PBXCopyFilesBuildPhase section */`,
+	`		E2B0F0282C8B4A0000A1B2C3 /* Embed Frameworks */ = {
+			isa = PBXCopyFilesBuildPhase;
+			buildActionMask = 2147483647;
+			dstPath = "";
+			dstSubfolderSpec = 10;
+			files = (
+				E2B0F0192C8B4A0000A1B2C3 /* Kit.framework in Embed Frameworks */,
+			);
+			name = "Embed Frameworks";
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+		E2B0F0292C8B4A0000A1B2C3 /* CopyFiles */ = {
+			isa = PBXCopyFilesBuildPhase;
+			buildActionMask = 2147483647;
+			dstPath = "";
+			dstSubfolderSpec = 7;
+			files = (
+				E2B0F01A2C8B4A0000A1B2C3 /* Kit.framework in CopyFiles */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXCopyFilesBuildPhase section */`,
+
+	`			buildConfigurationList = E2B0F0632C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "AppTests" */;
+			buildPhases = (
+			);`,
+	`			buildConfigurationList = E2B0F0632C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "AppTests" */;
+			buildPhases = (
+				E2B0F0292C8B4A0000A1B2C3 /* CopyFiles */,
+			);`,
+
+	`			buildConfigurationList = E2B0F0642C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "AppUITests" */;
+			buildPhases = (
+			);`,
+	`			buildConfigurationList = E2B0F0642C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "AppUITests" */;
+			buildPhases = (
+				E2B0F0282C8B4A0000A1B2C3 /* Embed Frameworks */,
+			);`,
+).Replace(pbxprojWithTestTargets)
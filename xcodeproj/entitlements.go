@@ -0,0 +1,254 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"github.com/bitrise-io/go-plist"
+	"github.com/bitrise-io/go-utils/pathutil"
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// buildSettingEntitlements maps the build settings used by Xcode to generate entitlements (macOS App Sandbox settings)
+// to the generated entitlement keys and the setting values to the entitlement values.
+var buildSettingEntitlements = map[string]map[string]map[string]interface{}{
+	"ENABLE_APP_SANDBOX": {
+		"YES": {appSandboxEntitlement: true},
+	},
+	"ENABLE_INCOMING_NETWORK_CONNECTIONS": {
+		"YES": {"com.apple.security.network.server": true},
+	},
+	"ENABLE_OUTGOING_NETWORK_CONNECTIONS": {
+		"YES": {"com.apple.security.network.client": true},
+	},
+	"ENABLE_USER_SELECTED_FILES": {
+		"readonly":  {"com.apple.security.files.user-selected.read-only": true},
+		"readwrite": {"com.apple.security.files.user-selected.read-write": true},
+	},
+}
+
+// TargetResolvedEntitlements returns the target's entitlements with every build setting reference resolved.
+// The entitlements are read from the CODE_SIGN_ENTITLEMENTS file, merged with the entitlements Xcode generates from
+// build settings (like ENABLE_APP_SANDBOX). References not found in the build settings, like $(AppIdentifierPrefix)
+// which is only known at signing time, are left unchanged.
+func (p XcodeProj) TargetResolvedEntitlements(target, configuration string) (serialized.Object, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	entitlements, err := p.entitlements(buildSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveEntitlements(entitlements, buildSettings), nil
+}
+
+// SetTargetEntitlementsPath sets the target's CODE_SIGN_ENTITLEMENTS build setting in the given configuration,
+// or in all of the target's configurations if the configuration is empty.
+// Absolute paths are stored relative to the project's directory (SRCROOT).
+// The project needs to be saved to persist the change.
+func (p XcodeProj) SetTargetEntitlementsPath(target, configuration, pth string) error {
+	if filepath.IsAbs(pth) {
+		relPth, err := filepath.Rel(filepath.Dir(p.Path), pth)
+		if err != nil {
+			return fmt.Errorf("failed to make entitlements path (%s) relative to the project: %s", pth, err)
+		}
+		pth = relPth
+	}
+
+	return p.setTargetBuildSetting(target, configuration, "CODE_SIGN_ENTITLEMENTS", filepath.ToSlash(pth))
+}
+
+// AddEntitlements merges the entitlements into the target's entitlements files and writes them back in their original format.
+// Array values (like the app groups) are union-merged, other values are overridden.
+// Every distinct CODE_SIGN_ENTITLEMENTS file of the given configuration (all configurations if empty) is updated,
+// a configured but missing file is created at the configured path.
+// Configurations without CODE_SIGN_ENTITLEMENTS get <target>/<target>.entitlements next to the project,
+// in which case the project is saved.
+func (p XcodeProj) AddEntitlements(target, configuration string, entitlements serialized.Object) error {
+	if err := p.checkOnDisk(); err != nil {
+		return err
+	}
+
+	buildConfigurations, err := p.targetBuildConfigurations(target, configuration)
+	if err != nil {
+		return err
+	}
+
+	updated := map[string]bool{}
+	var unsetConfigurations []string
+	for _, buildConfiguration := range buildConfigurations {
+		buildSettings, err := p.TargetBuildSettings(target, buildConfiguration.Name)
+		if err != nil {
+			return err
+		}
+
+		pth, err := p.entitlementsPath(buildSettings)
+		if err != nil {
+			return err
+		}
+		if pth == "" {
+			unsetConfigurations = append(unsetConfigurations, buildConfiguration.Name)
+			continue
+		}
+		if updated[pth] {
+			continue
+		}
+
+		if err := addEntitlementsToFile(pth, entitlements); err != nil {
+			return fmt.Errorf("failed to update entitlements: %s", err)
+		}
+		updated[pth] = true
+	}
+
+	if len(unsetConfigurations) == 0 {
+		return nil
+	}
+
+	relPth := filepath.Join(target, target+".entitlements")
+	if pth := filepath.Join(filepath.Dir(p.Path), relPth); !updated[pth] {
+		if err := addEntitlementsToFile(pth, entitlements); err != nil {
+			return fmt.Errorf("failed to create entitlements: %s", err)
+		}
+	}
+
+	for _, unsetConfiguration := range unsetConfigurations {
+		if err := p.SetTargetEntitlementsPath(target, unsetConfiguration, relPth); err != nil {
+			return err
+		}
+	}
+	return p.Save()
+}
+
+// addEntitlementsToFile merges the entitlements into the entitlements file at pth,
+// or creates the file (as an XML plist) if it does not exist.
+func addEntitlementsToFile(pth string, entitlements serialized.Object) error {
+	if exists, err := pathutil.IsPathExists(pth); err != nil {
+		return err
+	} else if exists {
+		current, _, err := ReadPlistFile(pth)
+		if err != nil {
+			return err
+		}
+		return updatePlistFile(pth, mergeEntitlements(current, entitlements))
+	}
+
+	if err := os.MkdirAll(filepath.Dir(pth), 0755); err != nil {
+		return err
+	}
+
+	content, err := marshalPlist(mergeEntitlements(serialized.Object{}, entitlements), plist.XMLFormat, false)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(pth, content, 0644)
+}
+
+func mergeEntitlements(current, entitlements serialized.Object) serialized.Object {
+	merged := serialized.Object{}
+	for key, value := range current {
+		merged[key] = value
+	}
+
+	for key, value := range entitlements {
+		currentValues, currentIsArray := merged[key].([]interface{})
+		values, isArray := value.([]interface{})
+		if !currentIsArray || !isArray {
+			merged[key] = value
+			continue
+		}
+
+		union := append([]interface{}{}, currentValues...)
+		for _, v := range values {
+			found := false
+			for _, currentValue := range currentValues {
+				if reflect.DeepEqual(currentValue, v) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				union = append(union, v)
+			}
+		}
+		merged[key] = union
+	}
+
+	return merged
+}
+
+// entitlements returns the content of the CODE_SIGN_ENTITLEMENTS file, or nil if the build setting is not set.
+func (p XcodeProj) entitlements(buildSettings serialized.Object) (serialized.Object, error) {
+	pth, err := p.entitlementsPath(buildSettings)
+	if err != nil || pth == "" {
+		return nil, err
+	}
+
+	entitlements, _, err := ReadPlistFile(pth)
+	return entitlements, err
+}
+
+// entitlementsPath returns the absolute path of the CODE_SIGN_ENTITLEMENTS file, or an empty string if it is not set.
+func (p XcodeProj) entitlementsPath(buildSettings serialized.Object) (string, error) {
+	if entitlementsPth, err := buildSettings.String("CODE_SIGN_ENTITLEMENTS"); err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return "", nil
+		}
+		return "", err
+	} else if entitlementsPth == "" {
+		return "", nil
+	}
+
+	return p.buildSettingsPath(buildSettings, "CODE_SIGN_ENTITLEMENTS")
+}
+
+func resolveEntitlements(entitlements, buildSettings serialized.Object) serialized.Object {
+	resolved := serialized.Object{}
+	for key, value := range entitlements {
+		resolved[key] = resolveValue(value, buildSettings)
+	}
+
+	for buildSetting, entitlementsByValue := range buildSettingEntitlements {
+		value, err := buildSettings.String(buildSetting)
+		if err != nil {
+			continue
+		}
+
+		for key, entitlement := range entitlementsByValue[value] {
+			if _, ok := resolved[key]; !ok {
+				resolved[key] = entitlement
+			}
+		}
+	}
+
+	return resolved
+}
+
+// resolveValue resolves the build setting references in the strings of the plist value, recursively.
+func resolveValue(value interface{}, buildSettings serialized.Object) interface{} {
+	switch v := value.(type) {
+	case string:
+		return resolveKnownReferences(v, buildSettings)
+	case []interface{}:
+		resolved := make([]interface{}, len(v))
+		for i, item := range v {
+			resolved[i] = resolveValue(item, buildSettings)
+		}
+		return resolved
+	case map[string]interface{}:
+		resolved := map[string]interface{}{}
+		for key, item := range v {
+			resolved[key] = resolveValue(item, buildSettings)
+		}
+		return resolved
+	case serialized.Object:
+		return resolveValue(map[string]interface{}(v), buildSettings)
+	default:
+		return value
+	}
+}
@@ -0,0 +1,198 @@
+package xcodeproj
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_TargetResolvedEntitlements(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithBuildFiles, map[string]string{
+		"../App/App.entitlements": entitlementsWithVariables,
+	})
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(func(target, configuration string) (serialized.Object, error) {
+		return serialized.Object{
+			"CODE_SIGN_ENTITLEMENTS":    "App/App.entitlements",
+			"PRODUCT_BUNDLE_IDENTIFIER": "io.bitrise.$(PRODUCT_NAME:rfc1034identifier)",
+			"PRODUCT_NAME":              "App",
+			"APS_ENVIRONMENT":           "production",
+			"APP_GROUP":                 "group.${PRODUCT_BUNDLE_IDENTIFIER}",
+		}, nil
+	})
+
+	entitlements, err := project.TargetResolvedEntitlements("App", "Release")
+	require.NoError(t, err)
+	require.Equal(t, serialized.Object{
+		"aps-environment":                                  "production",
+		"com.apple.security.application-groups":            []interface{}{"group.io.bitrise.App"},
+		"com.apple.developer.associated-domains":           []interface{}{"applinks:example.com"},
+		"keychain-access-groups":                           []interface{}{"$(AppIdentifierPrefix)io.bitrise.App"},
+		"com.apple.developer.icloud-container-environment": "Production",
+	}, entitlements)
+}
+
+func Test_resolveEntitlements_GeneratedEntitlements(t *testing.T) {
+	entitlements := resolveEntitlements(serialized.Object{
+		"com.apple.security.network.client": false,
+	}, serialized.Object{
+		"ENABLE_APP_SANDBOX":                  "YES",
+		"ENABLE_OUTGOING_NETWORK_CONNECTIONS": "YES",
+		"ENABLE_USER_SELECTED_FILES":          "readonly",
+		"ENABLE_INCOMING_NETWORK_CONNECTIONS": "NO",
+	})
+	require.Equal(t, serialized.Object{
+		"com.apple.security.app-sandbox":                   true,
+		"com.apple.security.network.client":                false,
+		"com.apple.security.files.user-selected.read-only": true,
+	}, entitlements)
+}
+
+const entitlementsWithVariables = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>aps-environment</key>
+	<string>$(APS_ENVIRONMENT)</string>
+	<key>com.apple.developer.associated-domains</key>
+	<array>
+		<string>applinks:example.com</string>
+	</array>
+	<key>com.apple.developer.icloud-container-environment</key>
+	<string>Production</string>
+	<key>com.apple.security.application-groups</key>
+	<array>
+		<string>$(APP_GROUP)</string>
+	</array>
+	<key>keychain-access-groups</key>
+	<array>
+		<string>$(AppIdentifierPrefix)$(PRODUCT_BUNDLE_IDENTIFIER)</string>
+	</array>
+</dict>
+</plist>
+`
+
+func TestXcodeProj_SetTargetEntitlementsPath(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithBuildFiles, nil)
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+
+	projectDir := filepath.Dir(projectPth)
+	require.NoError(t, project.SetTargetEntitlementsPath("App", "", filepath.Join(projectDir, "App", "App.entitlements")))
+	require.NoError(t, project.SetTargetEntitlementsPath("App", "Release", "App/Release.entitlements"))
+	require.NoError(t, project.Save())
+
+	project, err = Open(projectPth)
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(project))
+
+	for configuration, want := range map[string]string{"Debug": "App/App.entitlements", "Release": "App/Release.entitlements"} {
+		buildConfigurations, err := project.targetBuildConfigurations("App", configuration)
+		require.NoError(t, err)
+		require.Equal(t, want, buildConfigurations[0].BuildSettings["CODE_SIGN_ENTITLEMENTS"])
+
+		pth, err := project.TargetCodeSignEntitlementsPath("App", configuration)
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(projectDir, want), pth)
+	}
+}
+
+func TestXcodeProj_AddEntitlements(t *testing.T) {
+	t.Run("creates the entitlements file", func(t *testing.T) {
+		projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithBuildFiles, nil)
+		project, err := Open(projectPth)
+		require.NoError(t, err)
+		project.SetBuildSettingsProvider(rawBuildSettingsProvider(project))
+
+		require.NoError(t, project.AddEntitlements("App", "", serialized.Object{
+			"com.apple.security.application-groups": []interface{}{"group.io.bitrise.App"},
+		}))
+
+		project, err = Open(projectPth)
+		require.NoError(t, err)
+		project.SetBuildSettingsProvider(rawBuildSettingsProvider(project))
+
+		for _, configuration := range []string{"Debug", "Release"} {
+			entitlements, err := project.TargetCodeSignEntitlements("App", configuration)
+			require.NoError(t, err)
+			require.Equal(t, serialized.Object{
+				"com.apple.security.application-groups": []interface{}{"group.io.bitrise.App"},
+			}, entitlements)
+		}
+	})
+
+	t.Run("merges into the existing entitlements file", func(t *testing.T) {
+		projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithBuildFiles, map[string]string{
+			"../App/App.entitlements": appGroupsEntitlements,
+		})
+		project, err := Open(projectPth)
+		require.NoError(t, err)
+		project.SetBuildSettingsProvider(func(target, configuration string) (serialized.Object, error) {
+			return serialized.Object{"CODE_SIGN_ENTITLEMENTS": "App/App.entitlements"}, nil
+		})
+
+		require.NoError(t, project.AddEntitlements("App", "Debug", serialized.Object{
+			"aps-environment":                       "development",
+			"com.apple.security.application-groups": []interface{}{"group.io.bitrise.App", "group.io.bitrise.Shared"},
+		}))
+
+		entitlements, err := project.TargetCodeSignEntitlements("App", "Debug")
+		require.NoError(t, err)
+		require.Equal(t, serialized.Object{
+			"aps-environment":                        "development",
+			"com.apple.developer.associated-domains": []interface{}{"applinks:example.com"},
+			"com.apple.security.application-groups":  []interface{}{"group.io.bitrise.App", "group.io.bitrise.Shared"},
+		}, entitlements)
+	})
+
+	t.Run("merges into every configuration's entitlements file", func(t *testing.T) {
+		projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithBuildFiles, map[string]string{
+			"../App/App.entitlements": appGroupsEntitlements,
+		})
+		project, err := Open(projectPth)
+		require.NoError(t, err)
+		entitlementsPaths := map[string]string{"Debug": "App/App.entitlements", "Release": "App/Release.entitlements"}
+		project.SetBuildSettingsProvider(func(target, configuration string) (serialized.Object, error) {
+			return serialized.Object{"CODE_SIGN_ENTITLEMENTS": entitlementsPaths[configuration]}, nil
+		})
+
+		require.NoError(t, project.AddEntitlements("App", "", serialized.Object{
+			"com.apple.security.application-groups": []interface{}{"group.io.bitrise.Shared"},
+		}))
+
+		entitlements, err := project.TargetCodeSignEntitlements("App", "Debug")
+		require.NoError(t, err)
+		require.Equal(t, serialized.Object{
+			"aps-environment":                        "production",
+			"com.apple.developer.associated-domains": []interface{}{"applinks:example.com"},
+			"com.apple.security.application-groups":  []interface{}{"group.io.bitrise.App", "group.io.bitrise.Shared"},
+		}, entitlements)
+
+		entitlements, err = project.TargetCodeSignEntitlements("App", "Release")
+		require.NoError(t, err)
+		require.Equal(t, serialized.Object{
+			"com.apple.security.application-groups": []interface{}{"group.io.bitrise.Shared"},
+		}, entitlements)
+	})
+}
+
+const appGroupsEntitlements = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>aps-environment</key>
+	<string>production</string>
+	<key>com.apple.developer.associated-domains</key>
+	<array>
+		<string>applinks:example.com</string>
+	</array>
+	<key>com.apple.security.application-groups</key>
+	<array>
+		<string>group.io.bitrise.App</string>
+	</array>
+</dict>
+</plist>
+`
@@ -0,0 +1,245 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+const groupElementType = "PBXGroup"
+
+// GroupForPath returns the id of the group whose resolved path is the dirPath directory.
+// A relative dirPath is relative to the directory of the project.
+// The Path of a project opened by OpenFS is relative to its file system, so the dirPath needs to be relative as well.
+// If multiple groups resolve to the directory (like a group without a path and its parent),
+// the outermost one is returned.
+func (p XcodeProj) GroupForPath(dirPath string) (string, error) {
+	return p.groupForPath(dirPath, false)
+}
+
+// CreateGroupForPath returns the id of the group whose resolved path is the dirPath directory, like GroupForPath,
+// but instead of failing, it creates the missing groups under the closest group containing the directory.
+// The project needs to be saved to persist the change.
+func (p XcodeProj) CreateGroupForPath(dirPath string) (string, error) {
+	return p.groupForPath(dirPath, true)
+}
+
+// AddGroup creates a new group in the parent group and returns its id.
+// The group's path is relative to the parent group, an empty path creates a group without a folder.
+// The name is only stored if it differs from the path, as Xcode displays the path otherwise.
+// The project needs to be saved to persist the change.
+func (p XcodeProj) AddGroup(parentGroupID, name, path string) (string, error) {
+	if name == "" && path == "" {
+		return "", fmt.Errorf("no name or path provided for the group")
+	}
+
+	objects, err := p.RawProj.Object("objects")
+	if err != nil {
+		return "", err
+	}
+
+	parentGroup, err := objects.Object(parentGroupID)
+	if err != nil {
+		return "", fmt.Errorf("parent group not found: %s", parentGroupID)
+	}
+
+	if isa, err := parentGroup.String("isa"); err != nil {
+		return "", err
+	} else if isa != groupElementType {
+		return "", fmt.Errorf("parent (%s) is not a %s but a %s", parentGroupID, groupElementType, isa)
+	}
+
+	id, err := newObjectID(objects)
+	if err != nil {
+		return "", err
+	}
+
+	group := map[string]interface{}{
+		"isa":        groupElementType,
+		"children":   []interface{}{},
+		"sourceTree": "<group>",
+	}
+	if path != "" {
+		group["path"] = path
+	}
+	if name != "" && name != path {
+		group["name"] = name
+	}
+	objects[id] = group
+
+	if err := appendGroupChild(parentGroupID, id, objects); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+type groupPath struct {
+	id   string
+	path string
+}
+
+func (p XcodeProj) groupForPath(dirPath string, create bool) (string, error) {
+	objects, err := p.RawProj.Object("objects")
+	if err != nil {
+		return "", err
+	}
+
+	project, err := objects.Object(p.Proj.ID)
+	if err != nil {
+		return "", err
+	}
+
+	mainGroupID, err := project.String("mainGroup")
+	if err != nil {
+		return "", err
+	}
+
+	projectDirPath, err := optionalString(project, "projectDirPath")
+	if err != nil {
+		return "", err
+	}
+
+	sourceRoot := filepath.Dir(p.Path)
+	if p.fsBacked && filepath.IsAbs(dirPath) {
+		return "", fmt.Errorf("project (%s) is opened from a file system, the group's path needs to be relative: %s", p.Path, dirPath)
+	}
+	if !filepath.IsAbs(dirPath) {
+		dirPath = filepath.Join(sourceRoot, dirPath)
+	}
+	dirPath = filepath.Clean(dirPath)
+
+	groups, err := groupPaths(mainGroupID, filepath.Join(sourceRoot, projectDirPath), sourceRoot, objects, map[string]bool{})
+	if err != nil {
+		return "", err
+	}
+
+	var closest *groupPath
+	for i, group := range groups {
+		if group.path == dirPath {
+			return group.id, nil
+		}
+
+		if isSubpath(group.path, dirPath) && (closest == nil || len(group.path) > len(closest.path)) {
+			closest = &groups[i]
+		}
+	}
+
+	if !create {
+		return "", fmt.Errorf("no group found for path: %s", dirPath)
+	}
+	if closest == nil {
+		return "", fmt.Errorf("path (%s) is not inside any of the project's groups", dirPath)
+	}
+
+	relativePath, err := filepath.Rel(closest.path, dirPath)
+	if err != nil {
+		return "", err
+	}
+
+	parentID := closest.id
+	for _, component := range strings.Split(relativePath, string(filepath.Separator)) {
+		id, err := p.AddGroup(parentID, "", component)
+		if err != nil {
+			return "", err
+		}
+		parentID = id
+	}
+
+	return parentID, nil
+}
+
+// groupPaths returns the group and its descendant groups with their resolved paths, parents before their children.
+// Groups relative to unsupported locations (like BUILT_PRODUCTS_DIR) are skipped.
+func groupPaths(id, dir, sourceRoot string, objects serialized.Object, visited map[string]bool) ([]groupPath, error) {
+	if visited[id] {
+		return nil, fmt.Errorf("circular reference in project, id: %s", id)
+	}
+	visited[id] = true
+
+	group, err := objects.Object(id)
+	if err != nil {
+		return nil, err
+	}
+
+	children, err := group.StringSlice("children")
+	if err != nil && !serialized.IsKeyNotFoundError(err) {
+		return nil, err
+	}
+
+	paths := []groupPath{{id: id, path: filepath.Clean(dir)}}
+	for _, childID := range children {
+		child, err := objects.Object(childID)
+		if err != nil {
+			return nil, err
+		}
+
+		if isa, err := child.String("isa"); err != nil {
+			return nil, err
+		} else if isa != groupElementType {
+			continue
+		}
+
+		childPath, err := optionalString(child, "path")
+		if err != nil {
+			return nil, err
+		}
+
+		sourceTree, err := optionalString(child, "sourceTree")
+		if err != nil {
+			return nil, err
+		}
+
+		var childDir string
+		switch sourceTree {
+		case "<group>":
+			childDir = filepath.Join(dir, childPath)
+		case "SOURCE_ROOT":
+			childDir = filepath.Join(sourceRoot, childPath)
+		case "<absolute>":
+			childDir = childPath
+		default:
+			continue
+		}
+
+		childPaths, err := groupPaths(childID, childDir, sourceRoot, objects, visited)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, childPaths...)
+	}
+
+	return paths, nil
+}
+
+// appendGroupChild adds the child object to the end of the group's children.
+func appendGroupChild(groupID, childID string, objects serialized.Object) error {
+	group, err := objects.Object(groupID)
+	if err != nil {
+		return err
+	}
+
+	children, err := group.StringSlice("children")
+	if err != nil && !serialized.IsKeyNotFoundError(err) {
+		return err
+	}
+
+	var rawChildren []interface{}
+	for _, child := range children {
+		rawChildren = append(rawChildren, child)
+	}
+	group["children"] = append(rawChildren, childID)
+
+	return nil
+}
+
+// isSubpath reports whether pth is inside the dir directory.
+func isSubpath(dir, pth string) bool {
+	relativePath, err := filepath.Rel(dir, pth)
+	if err != nil {
+		return false
+	}
+	return relativePath != ".." && !strings.HasPrefix(relativePath, ".."+string(filepath.Separator))
+}
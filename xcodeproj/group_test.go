@@ -0,0 +1,158 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_GroupForPath(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithNestedGroups))
+	require.NoError(t, err)
+	project.Path = "/Users/bitrise/App/App.xcodeproj"
+
+	tests := []struct {
+		name    string
+		dirPath string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "main group",
+			dirPath: ".",
+			want:    "E2B0F0302C8B4A0000A1B2C3",
+		},
+		{
+			name:    "nested group",
+			dirPath: "App/Sources/Views",
+			want:    "E2B0F0362C8B4A0000A1B2C3",
+		},
+		{
+			name:    "absolute path, group without path inside",
+			dirPath: "/Users/bitrise/App/App/Sources",
+			want:    "E2B0F0352C8B4A0000A1B2C3",
+		},
+		{
+			name:    "no group for path",
+			dirPath: "App/Sources/Models",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := project.GroupForPath(tt.dirPath)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestXcodeProj_CreateGroupForPath(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithNestedGroups))
+	require.NoError(t, err)
+	project.Path = "/Users/bitrise/App/App.xcodeproj"
+
+	id, err := project.CreateGroupForPath("App/Sources/Views")
+	require.NoError(t, err)
+	require.Equal(t, "E2B0F0362C8B4A0000A1B2C3", id)
+
+	id, err = project.CreateGroupForPath("App/Sources/Views/Cells/Detail")
+	require.NoError(t, err)
+
+	got, err := project.GroupForPath("App/Sources/Views/Cells/Detail")
+	require.NoError(t, err)
+	require.Equal(t, id, got)
+
+	cellsID, err := project.GroupForPath("App/Sources/Views/Cells")
+	require.NoError(t, err)
+	require.Equal(t, "Cells", project.ObjectName(cellsID))
+
+	objects, err := project.RawProj.Object("objects")
+	require.NoError(t, err)
+
+	views, err := objects.Object("E2B0F0362C8B4A0000A1B2C3")
+	require.NoError(t, err)
+	children, err := views.StringSlice("children")
+	require.NoError(t, err)
+	require.Equal(t, cellsID, children[len(children)-1])
+
+	_, err = project.CreateGroupForPath("/Users/bitrise/Shared")
+	require.Error(t, err)
+}
+
+// pbxprojWithNestedGroups has an App/Sources group, with a nested Views group and a Supporting Files group without a path.
+var pbxprojWithNestedGroups = newFixtureReplacer(
+	`				E2B0F0082C8B4A0000A1B2C3 /* Assets.xcassets */,
+			);
+			path = App;
+			sourceTree = "<group>";
+		};`, `				E2B0F0082C8B4A0000A1B2C3 /* Assets.xcassets */,
+				E2B0F0352C8B4A0000A1B2C3 /* Sources */,
+			);
+			path = App;
+			sourceTree = "<group>";
+		};
+		E2B0F0352C8B4A0000A1B2C3 /* Sources */ = {
+			isa = PBXGroup;
+			children = (
+				E2B0F0372C8B4A0000A1B2C3 /* Supporting Files */,
+				E2B0F0362C8B4A0000A1B2C3 /* Views */,
+			);
+			path = Sources;
+			sourceTree = "<group>";
+		};
+		E2B0F0362C8B4A0000A1B2C3 /* Views */ = {
+			isa = PBXGroup;
+			children = (
+			);
+			path = Views;
+			sourceTree = "<group>";
+		};
+		E2B0F0372C8B4A0000A1B2C3 /* Supporting Files */ = {
+			isa = PBXGroup;
+			children = (
+			);
+			name = "Supporting Files";
+			sourceTree = "<group>";
+		};`,
+).Replace(pbxprojWithBuildFiles)
+
+func TestXcodeProj_AddGroup(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithNestedGroups, nil)
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+
+	modelsID, err := project.AddGroup("E2B0F0352C8B4A0000A1B2C3", "Models", "Models")
+	require.NoError(t, err)
+	resourcesID, err := project.AddGroup("E2B0F0352C8B4A0000A1B2C3", "Resources", "")
+	require.NoError(t, err)
+	require.NotEqual(t, modelsID, resourcesID)
+
+	_, err = project.AddGroup("E2B0F0FF2C8B4A0000A1B2C3", "Models", "Models")
+	require.Error(t, err)
+	_, err = project.AddGroup("E2B0F0032C8B4A0000A1B2C3", "Models", "Models")
+	require.Error(t, err)
+
+	require.NoError(t, project.Save())
+
+	project, err = Open(projectPth)
+	require.NoError(t, err)
+
+	got, err := project.GroupForPath("App/Sources/Models")
+	require.NoError(t, err)
+	require.Equal(t, modelsID, got)
+	require.Equal(t, "Models", project.ObjectName(modelsID))
+	require.Equal(t, "Resources", project.ObjectName(resourcesID))
+
+	objects, err := project.RawProj.Object("objects")
+	require.NoError(t, err)
+	sources, err := objects.Object("E2B0F0352C8B4A0000A1B2C3")
+	require.NoError(t, err)
+	children, err := sources.StringSlice("children")
+	require.NoError(t, err)
+	require.Equal(t, []string{"E2B0F0372C8B4A0000A1B2C3", "E2B0F0362C8B4A0000A1B2C3", modelsID, resourcesID}, children)
+}
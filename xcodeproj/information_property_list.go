@@ -0,0 +1,450 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bitrise-io/go-plist"
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// generatedInformationPropertyListKeyPrefix is the prefix of the build settings used to generate the Info.plist
+// (GENERATE_INFOPLIST_FILE = YES), like INFOPLIST_KEY_UILaunchStoryboardName.
+const generatedInformationPropertyListKeyPrefix = "INFOPLIST_KEY_"
+
+// TargetMainStoryboard returns the name of the target's main storyboard file.
+// The value is read from the Info.plist's UIMainStoryboardFile (NSMainStoryboardFile on macOS) key,
+// the scene manifest's storyboard or the corresponding INFOPLIST_KEY_* build setting.
+// An empty name is returned for targets without a main storyboard, like SwiftUI lifecycle apps.
+func (p XcodeProj) TargetMainStoryboard(target, configuration string) (string, error) {
+	buildSettings, infoPlist, err := p.targetBuildSettingsAndInformationPropertyList(target, configuration)
+	if err != nil {
+		return "", err
+	}
+
+	return mainStoryboard(infoPlist, buildSettings)
+}
+
+// TargetLaunchScreen returns the name of the target's launch screen storyboard file.
+// The value is read from the Info.plist's UILaunchStoryboardName key or the INFOPLIST_KEY_UILaunchStoryboardName build setting.
+// An empty name is returned for targets without a launch storyboard, like SwiftUI lifecycle apps using UILaunchScreen.
+func (p XcodeProj) TargetLaunchScreen(target, configuration string) (string, error) {
+	buildSettings, infoPlist, err := p.targetBuildSettingsAndInformationPropertyList(target, configuration)
+	if err != nil {
+		return "", err
+	}
+
+	launchScreen, _, err := informationPropertyListString(infoPlist, buildSettings, "UILaunchStoryboardName")
+	return launchScreen, err
+}
+
+// supportedOrientationsKeys are the Info.plist keys of the supported interface orientations (general, iPhone and iPad),
+// mapped to the corresponding INFOPLIST_KEY_* build settings used for generated Info.plist files.
+var supportedOrientationsKeys = []struct{ infoPlistKey, buildSettingKey string }{
+	{infoPlistKey: "UISupportedInterfaceOrientations", buildSettingKey: "INFOPLIST_KEY_UISupportedInterfaceOrientations"},
+	{infoPlistKey: "UISupportedInterfaceOrientations~iphone", buildSettingKey: "INFOPLIST_KEY_UISupportedInterfaceOrientations_iPhone"},
+	{infoPlistKey: "UISupportedInterfaceOrientations~ipad", buildSettingKey: "INFOPLIST_KEY_UISupportedInterfaceOrientations_iPad"},
+}
+
+// TargetSupportedOrientations returns the interface orientations (like UIInterfaceOrientationPortrait) supported by the target
+// on any device: the union of the Info.plist's UISupportedInterfaceOrientations keys (including the ~iphone and ~ipad variants),
+// or of the corresponding INFOPLIST_KEY_UISupportedInterfaceOrientations* build settings.
+func (p XcodeProj) TargetSupportedOrientations(target, configuration string) ([]string, error) {
+	buildSettings, infoPlist, err := p.targetBuildSettingsAndInformationPropertyList(target, configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return supportedOrientations(infoPlist, buildSettings)
+}
+
+func supportedOrientations(infoPlist, buildSettings serialized.Object) ([]string, error) {
+	var orientations []string
+	added := map[string]bool{}
+	for _, keys := range supportedOrientationsKeys {
+		values, err := infoPlist.StringSlice(keys.infoPlistKey)
+		if err != nil {
+			if !serialized.IsKeyNotFoundError(err) {
+				return nil, err
+			}
+			if values, err = buildSettingList(buildSettings, keys.buildSettingKey); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, value := range values {
+			if strings.Contains(value, "$") {
+				if value, err = Resolve(value, buildSettings); err != nil {
+					return nil, err
+				}
+			}
+
+			if value != "" && !added[value] {
+				added[value] = true
+				orientations = append(orientations, value)
+			}
+		}
+	}
+	return orientations, nil
+}
+
+// TargetRequiredDeviceCapabilities returns the device capabilities (like arm64 or nfc) required to install the target's app,
+// read from the Info.plist's UIRequiredDeviceCapabilities key or the INFOPLIST_KEY_UIRequiredDeviceCapabilities build setting.
+// Both the list and the dictionary form of the key are supported; the capabilities the dictionary prohibits (set to false) are omitted.
+func (p XcodeProj) TargetRequiredDeviceCapabilities(target, configuration string) ([]string, error) {
+	buildSettings, infoPlist, err := p.targetBuildSettingsAndInformationPropertyList(target, configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return requiredDeviceCapabilities(infoPlist, buildSettings)
+}
+
+func requiredDeviceCapabilities(infoPlist, buildSettings serialized.Object) ([]string, error) {
+	const key = "UIRequiredDeviceCapabilities"
+
+	value, err := infoPlist.Value(key)
+	if err != nil {
+		if !serialized.IsKeyNotFoundError(err) {
+			return nil, err
+		}
+		return buildSettingList(buildSettings, generatedInformationPropertyListKeyPrefix+key)
+	}
+
+	switch v := value.(type) {
+	case []interface{}:
+		return infoPlist.StringSlice(key)
+	case map[string]interface{}:
+		var capabilities []string
+		for _, capability := range sortedKeys(v) {
+			required, ok := v[capability].(bool)
+			if !ok {
+				return nil, serialized.NewTypeCastError(capability, v[capability], false)
+			}
+			if required {
+				capabilities = append(capabilities, capability)
+			}
+		}
+		return capabilities, nil
+	default:
+		return nil, fmt.Errorf("unsupported %s value: %v", key, value)
+	}
+}
+
+// TargetATSConfig returns the target's App Transport Security configuration: the Info.plist's NSAppTransportSecurity dictionary,
+// like {NSAllowsArbitraryLoads: true}. Nil is returned if the target has no Info.plist file or the key is not set.
+func (p XcodeProj) TargetATSConfig(target, configuration string) (serialized.Object, error) {
+	_, infoPlist, err := p.targetBuildSettingsAndInformationPropertyList(target, configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return atsConfig(infoPlist)
+}
+
+func atsConfig(infoPlist serialized.Object) (serialized.Object, error) {
+	ats, err := infoPlist.Object("NSAppTransportSecurity")
+	if err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return ats, nil
+}
+
+// extensionPointIdentifierKeys are the Info.plist dictionaries and keys declaring the extension point of app extensions
+// (NSExtension) and ExtensionKit extensions (EXAppExtensionAttributes).
+var extensionPointIdentifierKeys = []struct{ dictionary, key string }{
+	{dictionary: "NSExtension", key: "NSExtensionPointIdentifier"},
+	{dictionary: "EXAppExtensionAttributes", key: "EXExtensionPointIdentifier"},
+}
+
+// TargetExtensionPointIdentifier returns the extension point the target's app extension extends,
+// like com.apple.widgetkit-extension or com.apple.share-services: the NSExtensionPointIdentifier
+// of the Info.plist's NSExtension dictionary (EXExtensionPointIdentifier for ExtensionKit extensions).
+// An empty identifier is returned for targets which are not app extensions.
+func (p XcodeProj) TargetExtensionPointIdentifier(target, configuration string) (string, error) {
+	_, infoPlist, err := p.targetBuildSettingsAndInformationPropertyList(target, configuration)
+	if err != nil {
+		return "", err
+	}
+
+	return extensionPointIdentifier(infoPlist)
+}
+
+func extensionPointIdentifier(infoPlist serialized.Object) (string, error) {
+	for _, keys := range extensionPointIdentifierKeys {
+		attributes, err := infoPlist.Object(keys.dictionary)
+		if err != nil {
+			if serialized.IsKeyNotFoundError(err) {
+				continue
+			}
+			return "", err
+		}
+
+		identifier, err := optionalString(attributes, keys.key)
+		if err != nil || identifier != "" {
+			return identifier, err
+		}
+	}
+	return "", nil
+}
+
+// AllInfoPlistPaths returns the resolved Info.plist path of the native targets in the given configuration, keyed by target name.
+// Targets without an INFOPLIST_FILE build setting (like the ones using a generated Info.plist)
+// and targets missing the configuration are omitted.
+func (p XcodeProj) AllInfoPlistPaths(configuration string) (map[string]string, error) {
+	paths := map[string]string{}
+	for _, target := range p.Proj.Targets {
+		if target.Type != NativeTargetType {
+			continue
+		}
+
+		if !target.hasConfiguration(configuration) {
+			continue
+		}
+
+		buildSettings, err := p.TargetBuildSettings(target.Name, configuration)
+		if err != nil {
+			return nil, err
+		}
+
+		infoPlistFile, err := buildSettings.String("INFOPLIST_FILE")
+		if err != nil && !serialized.IsKeyNotFoundError(err) {
+			return nil, err
+		}
+		if infoPlistFile == "" {
+			continue
+		}
+
+		pth, err := p.buildSettingsPath(buildSettings, "INFOPLIST_FILE")
+		if err != nil {
+			return nil, err
+		}
+		paths[target.Name] = pth
+	}
+	return paths, nil
+}
+
+// TargetGeneratesInfoPlist reports whether Xcode generates the target's Info.plist (GENERATE_INFOPLIST_FILE = YES)
+// from the INFOPLIST_KEY_* build settings, merged with the INFOPLIST_FILE if the target has one.
+func (p XcodeProj) TargetGeneratesInfoPlist(target, configuration string) (bool, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return false, err
+	}
+
+	generates, _, err := boolBuildSetting(buildSettings, "GENERATE_INFOPLIST_FILE")
+	return generates, err
+}
+
+// targetBuildSettingsAndInformationPropertyList returns the target's build settings and Info.plist.
+// The returned Info.plist is nil if the target does not have an Info.plist file.
+func (p XcodeProj) targetBuildSettingsAndInformationPropertyList(target, configuration string) (serialized.Object, serialized.Object, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	infoPlist, err := p.informationPropertyList(buildSettings)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return buildSettings, infoPlist, nil
+}
+
+func (p XcodeProj) informationPropertyList(buildSettings serialized.Object) (serialized.Object, error) {
+	if infoPlistFile, err := buildSettings.String("INFOPLIST_FILE"); err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	} else if infoPlistFile == "" {
+		return nil, nil
+	}
+
+	pth, err := p.buildSettingsPath(buildSettings, "INFOPLIST_FILE")
+	if err != nil {
+		return nil, err
+	}
+
+	infoPlist, _, err := ReadPlistFile(pth)
+	return infoPlist, err
+}
+
+// Info.plist output formats (INFOPLIST_OUTPUT_FORMAT build setting values).
+const (
+	InformationPropertyListOutputFormatSameAsInput = "same-as-input"
+	InformationPropertyListOutputFormatXML         = "XML"
+	InformationPropertyListOutputFormatBinary      = "binary"
+)
+
+// TargetInformationPropertyListOutputFormat returns the target's INFOPLIST_OUTPUT_FORMAT build setting,
+// the format Xcode writes the product's Info.plist in:
+// InformationPropertyListOutputFormatSameAsInput (the default), InformationPropertyListOutputFormatXML or InformationPropertyListOutputFormatBinary.
+func (p XcodeProj) TargetInformationPropertyListOutputFormat(target, configuration string) (string, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return "", err
+	}
+
+	return informationPropertyListOutputFormat(buildSettings)
+}
+
+func informationPropertyListOutputFormat(buildSettings serialized.Object) (string, error) {
+	format, found, err := resolvedBuildSetting(buildSettings, "INFOPLIST_OUTPUT_FORMAT")
+	if err != nil {
+		return "", err
+	} else if !found || format == "" {
+		return InformationPropertyListOutputFormatSameAsInput, nil
+	}
+
+	for _, known := range []string{InformationPropertyListOutputFormatSameAsInput, InformationPropertyListOutputFormatXML, InformationPropertyListOutputFormatBinary} {
+		if strings.EqualFold(format, known) {
+			return known, nil
+		}
+	}
+	return "", fmt.Errorf("unknown INFOPLIST_OUTPUT_FORMAT: %s", format)
+}
+
+// SetTargetInformationPropertyListValue sets the key to the value in the target's Info.plist file.
+// The file keeps its format: a binary Info.plist stays binary, an XML one stays XML.
+// Error is returned if the target has no Info.plist file in the given configuration.
+func (p XcodeProj) SetTargetInformationPropertyListValue(target, configuration, key string, value interface{}) error {
+	pth, err := p.TargetInformationPropertyListPath(target, configuration)
+	if err != nil {
+		return err
+	}
+
+	return updatePlistFile(pth, serialized.Object{key: value})
+}
+
+// SetTargetInformationPropertyListValueInOutputFormat sets the key to the value in the target's Info.plist file,
+// like SetTargetInformationPropertyListValue, but writes the file in the target's Info.plist output format
+// (see TargetInformationPropertyListOutputFormat), for the tools reading the source Info.plist as if it was the built one.
+// The file keeps its format if the output format is InformationPropertyListOutputFormatSameAsInput.
+func (p XcodeProj) SetTargetInformationPropertyListValueInOutputFormat(target, configuration, key string, value interface{}) error {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return err
+	}
+
+	pth, err := p.buildSettingsPath(buildSettings, "INFOPLIST_FILE")
+	if err != nil {
+		return err
+	}
+
+	format, err := informationPropertyListPlistFormat(buildSettings)
+	if err != nil {
+		return err
+	}
+
+	return updatePlistFileInFormat(pth, serialized.Object{key: value}, format)
+}
+
+// informationPropertyListPlistFormat returns the plist format (like plist.BinaryFormat) of the INFOPLIST_OUTPUT_FORMAT build setting,
+// or 0 if the file's format is kept.
+func informationPropertyListPlistFormat(buildSettings serialized.Object) (int, error) {
+	format, err := informationPropertyListOutputFormat(buildSettings)
+	if err != nil {
+		return 0, err
+	}
+
+	switch format {
+	case InformationPropertyListOutputFormatXML:
+		return plist.XMLFormat, nil
+	case InformationPropertyListOutputFormatBinary:
+		return plist.BinaryFormat, nil
+	default:
+		return 0, nil
+	}
+}
+
+func mainStoryboard(infoPlist, buildSettings serialized.Object) (string, error) {
+	for _, key := range []string{"UIMainStoryboardFile", "NSMainStoryboardFile"} {
+		storyboard, found, err := informationPropertyListString(infoPlist, buildSettings, key)
+		if err != nil {
+			return "", err
+		} else if found {
+			return storyboard, nil
+		}
+	}
+
+	return sceneManifestStoryboard(infoPlist)
+}
+
+// sceneManifestStoryboard returns the storyboard of the first application scene configuration
+// from the Info.plist's UIApplicationSceneManifest.
+func sceneManifestStoryboard(infoPlist serialized.Object) (string, error) {
+	manifest, err := infoPlist.Object("UIApplicationSceneManifest")
+	if err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	sceneConfigurations, err := manifest.Object("UISceneConfigurations")
+	if err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	rawApplicationScenes, err := sceneConfigurations.Value("UIWindowSceneSessionRoleApplication")
+	if err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	applicationScenes, ok := rawApplicationScenes.([]interface{})
+	if !ok || len(applicationScenes) == 0 {
+		return "", nil
+	}
+
+	applicationScene, ok := applicationScenes[0].(map[string]interface{})
+	if !ok {
+		return "", nil
+	}
+
+	storyboard, err := serialized.Object(applicationScene).String("UISceneStoryboardFile")
+	if err != nil && !serialized.IsKeyNotFoundError(err) {
+		return "", err
+	}
+	return storyboard, nil
+}
+
+// informationPropertyListString returns the resolved string value of the key from the Info.plist,
+// falling back to the INFOPLIST_KEY_<key> build setting used for generated Info.plist files.
+// The returned bool reports whether the key was found.
+func informationPropertyListString(infoPlist, buildSettings serialized.Object, key string) (string, bool, error) {
+	value, err := infoPlist.String(key)
+	if err != nil {
+		if !serialized.IsKeyNotFoundError(err) {
+			return "", false, err
+		}
+
+		value, err = buildSettings.String(generatedInformationPropertyListKeyPrefix + key)
+		if err != nil {
+			if serialized.IsKeyNotFoundError(err) {
+				return "", false, nil
+			}
+			return "", false, err
+		}
+	}
+
+	if strings.Contains(value, "$") {
+		resolved, err := Resolve(value, buildSettings)
+		if err != nil {
+			return "", false, err
+		}
+		return resolved, true, nil
+	}
+
+	return value, true, nil
+}
@@ -0,0 +1,584 @@
+package xcodeproj
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bitrise-io/go-plist"
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_mainStoryboard(t *testing.T) {
+	tests := []struct {
+		name          string
+		infoPlist     string
+		buildSettings serialized.Object
+		want          string
+	}{
+		{
+			name:      "storyboard based app",
+			infoPlist: storyboardAppInfoPlist,
+			want:      "Main",
+		},
+		{
+			name:      "scene based app",
+			infoPlist: sceneStoryboardAppInfoPlist,
+			want:      "Main",
+		},
+		{
+			name:      "SwiftUI app",
+			infoPlist: swiftUIAppInfoPlist,
+			want:      "",
+		},
+		{
+			name: "generated Info.plist",
+			buildSettings: serialized.Object{
+				"INFOPLIST_KEY_UIMainStoryboardFile": "$(STORYBOARD_NAME)",
+				"STORYBOARD_NAME":                    "Main",
+			},
+			want: "Main",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			infoPlist := unmarshalInformationPropertyList(t, tt.infoPlist)
+
+			got, err := mainStoryboard(infoPlist, tt.buildSettings)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_launchScreen(t *testing.T) {
+	tests := []struct {
+		name          string
+		infoPlist     string
+		buildSettings serialized.Object
+		want          string
+		wantFound     bool
+	}{
+		{
+			name:      "storyboard based app",
+			infoPlist: storyboardAppInfoPlist,
+			want:      "LaunchScreen",
+			wantFound: true,
+		},
+		{
+			name:      "SwiftUI app",
+			infoPlist: swiftUIAppInfoPlist,
+		},
+		{
+			name: "generated Info.plist",
+			buildSettings: serialized.Object{
+				"INFOPLIST_KEY_UILaunchStoryboardName": "LaunchScreen",
+			},
+			want:      "LaunchScreen",
+			wantFound: true,
+		},
+		{
+			name: "generated Info.plist of a SwiftUI app",
+			buildSettings: serialized.Object{
+				"INFOPLIST_KEY_UILaunchScreen_Generation": "YES",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			infoPlist := unmarshalInformationPropertyList(t, tt.infoPlist)
+
+			got, found, err := informationPropertyListString(infoPlist, tt.buildSettings, "UILaunchStoryboardName")
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.wantFound, found)
+		})
+	}
+}
+
+func Test_supportedOrientations(t *testing.T) {
+	tests := []struct {
+		name          string
+		infoPlist     string
+		buildSettings serialized.Object
+		want          []string
+	}{
+		{
+			name:      "Info.plist",
+			infoPlist: orientationsInfoPlist,
+			buildSettings: serialized.Object{
+				"LANDSCAPE_ORIENTATION": "UIInterfaceOrientationLandscapeLeft",
+			},
+			want: []string{
+				"UIInterfaceOrientationPortrait",
+				"UIInterfaceOrientationLandscapeLeft",
+				"UIInterfaceOrientationPortraitUpsideDown",
+			},
+		},
+		{
+			name: "generated Info.plist",
+			buildSettings: serialized.Object{
+				"INFOPLIST_KEY_UISupportedInterfaceOrientations_iPhone": "UIInterfaceOrientationPortrait",
+				"INFOPLIST_KEY_UISupportedInterfaceOrientations_iPad":   "UIInterfaceOrientationPortrait UIInterfaceOrientationLandscapeRight",
+			},
+			want: []string{
+				"UIInterfaceOrientationPortrait",
+				"UIInterfaceOrientationLandscapeRight",
+			},
+		},
+		{
+			name:      "no orientations",
+			infoPlist: swiftUIAppInfoPlist,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			infoPlist := unmarshalInformationPropertyList(t, tt.infoPlist)
+
+			got, err := supportedOrientations(infoPlist, tt.buildSettings)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_requiredDeviceCapabilities(t *testing.T) {
+	tests := []struct {
+		name          string
+		infoPlist     string
+		buildSettings serialized.Object
+		want          []string
+		wantErr       bool
+	}{
+		{
+			name:      "list",
+			infoPlist: requiredDeviceCapabilitiesListInfoPlist,
+			want:      []string{"arm64", "nfc"},
+		},
+		{
+			name:      "dictionary",
+			infoPlist: requiredDeviceCapabilitiesDictionaryInfoPlist,
+			want:      []string{"arm64", "metal"},
+		},
+		{
+			name:          "generated Info.plist",
+			buildSettings: serialized.Object{"INFOPLIST_KEY_UIRequiredDeviceCapabilities": "armv7"},
+			want:          []string{"armv7"},
+		},
+		{
+			name:      "not set",
+			infoPlist: swiftUIAppInfoPlist,
+		},
+		{
+			name:      "invalid dictionary value",
+			infoPlist: strings.Replace(requiredDeviceCapabilitiesDictionaryInfoPlist, "<false/>", "<string>NO</string>", 1),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			infoPlist := unmarshalInformationPropertyList(t, tt.infoPlist)
+
+			got, err := requiredDeviceCapabilities(infoPlist, tt.buildSettings)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func unmarshalInformationPropertyList(t *testing.T, content string) serialized.Object {
+	if content == "" {
+		return nil
+	}
+
+	var infoPlist serialized.Object
+	_, err := plist.Unmarshal([]byte(content), &infoPlist)
+	require.NoError(t, err)
+	return infoPlist
+}
+
+func Test_atsConfig(t *testing.T) {
+	ats, err := atsConfig(unmarshalInformationPropertyList(t, arbitraryLoadsInfoPlist))
+	require.NoError(t, err)
+	require.Equal(t, serialized.Object{
+		"NSAllowsArbitraryLoads": true,
+		"NSExceptionDomains": map[string]interface{}{
+			"example.com": map[string]interface{}{
+				"NSIncludesSubdomains":               true,
+				"NSExceptionMinimumTLSVersion":       "TLSv1.0",
+				"NSExceptionAllowsInsecureHTTPLoads": true,
+			},
+		},
+	}, ats)
+
+	ats, err = atsConfig(unmarshalInformationPropertyList(t, swiftUIAppInfoPlist))
+	require.NoError(t, err)
+	require.Nil(t, ats)
+
+	_, err = atsConfig(unmarshalInformationPropertyList(t, strings.Replace(arbitraryLoadsInfoPlist, "<key>NSAppTransportSecurity</key>\n\t<dict>", "<key>NSAppTransportSecurity</key>\n\t<string>invalid</string>\n\t<key>Unused</key>\n\t<dict>", 1)))
+	require.Error(t, err)
+}
+
+func Test_extensionPointIdentifier(t *testing.T) {
+	tests := []struct {
+		name      string
+		infoPlist string
+		want      string
+	}{
+		{
+			name:      "widget extension",
+			infoPlist: widgetExtensionInfoPlist,
+			want:      "com.apple.widgetkit-extension",
+		},
+		{
+			name:      "notification service extension",
+			infoPlist: notificationServiceExtensionInfoPlist,
+			want:      "com.apple.usernotifications.service",
+		},
+		{
+			name:      "app",
+			infoPlist: swiftUIAppInfoPlist,
+			want:      "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := extensionPointIdentifier(unmarshalInformationPropertyList(t, tt.infoPlist))
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestXcodeProj_SetTargetInformationPropertyListValue(t *testing.T) {
+	var infoPlist serialized.Object
+	_, err := plist.Unmarshal([]byte(storyboardAppInfoPlist), &infoPlist)
+	require.NoError(t, err)
+	binaryInfoPlist, err := plist.Marshal(infoPlist, plist.BinaryFormat)
+	require.NoError(t, err)
+
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithBuildFiles, map[string]string{
+		"../App/Info.plist": string(binaryInfoPlist),
+	})
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(project))
+
+	require.NoError(t, project.SetTargetInformationPropertyListValue("App", "Debug", "CFBundleShortVersionString", "2.0.0"))
+
+	pth, err := project.TargetInformationPropertyListPath("App", "Debug")
+	require.NoError(t, err)
+	infoPlist, format, err := ReadPlistFile(pth)
+	require.NoError(t, err)
+	require.Equal(t, plist.BinaryFormat, format)
+	require.Equal(t, "2.0.0", infoPlist["CFBundleShortVersionString"])
+	require.Equal(t, "Main", infoPlist["UIMainStoryboardFile"])
+
+	require.Error(t, project.SetTargetInformationPropertyListValue("Missing", "Debug", "CFBundleShortVersionString", "2.0.0"))
+}
+
+func TestXcodeProj_TargetInformationPropertyListOutputFormat(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithBinaryInfoPlistOutput, map[string]string{
+		"../App/Info.plist": storyboardAppInfoPlist,
+	})
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(project))
+
+	format, err := project.TargetInformationPropertyListOutputFormat("App", "Release")
+	require.NoError(t, err)
+	require.Equal(t, InformationPropertyListOutputFormatBinary, format)
+
+	format, err = project.TargetInformationPropertyListOutputFormat("Kit", "Release")
+	require.NoError(t, err)
+	require.Equal(t, InformationPropertyListOutputFormatSameAsInput, format)
+
+	// The output format applies to the built product, the source Info.plist keeps its format.
+	require.NoError(t, project.SetTargetInformationPropertyListValue("App", "Release", "CFBundleShortVersionString", "2.0.0"))
+
+	pth, err := project.TargetInformationPropertyListPath("App", "Release")
+	require.NoError(t, err)
+	infoPlist, plistFormat, err := ReadPlistFile(pth)
+	require.NoError(t, err)
+	require.Equal(t, plist.XMLFormat, plistFormat)
+	require.Equal(t, "2.0.0", infoPlist["CFBundleShortVersionString"])
+}
+
+func TestXcodeProj_SetTargetInformationPropertyListValueInOutputFormat(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithBinaryInfoPlistOutput, map[string]string{
+		"../App/Info.plist": storyboardAppInfoPlist,
+		"../Kit/Info.plist": storyboardAppInfoPlist,
+	})
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(project))
+
+	for _, tt := range []struct {
+		target     string
+		wantFormat int
+	}{
+		{target: "App", wantFormat: plist.BinaryFormat},
+		{target: "Kit", wantFormat: plist.XMLFormat},
+	} {
+		require.NoError(t, project.SetTargetInformationPropertyListValueInOutputFormat(tt.target, "Release", "CFBundleShortVersionString", "2.0.0"))
+
+		pth, err := project.TargetInformationPropertyListPath(tt.target, "Release")
+		require.NoError(t, err)
+		infoPlist, format, err := ReadPlistFile(pth)
+		require.NoError(t, err)
+		require.Equal(t, tt.wantFormat, format, tt.target)
+		require.Equal(t, "2.0.0", infoPlist["CFBundleShortVersionString"], tt.target)
+	}
+}
+
+func Test_informationPropertyListOutputFormat(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{value: "", want: InformationPropertyListOutputFormatSameAsInput},
+		{value: "same-as-input", want: InformationPropertyListOutputFormatSameAsInput},
+		{value: "xml", want: InformationPropertyListOutputFormatXML},
+		{value: "binary", want: InformationPropertyListOutputFormatBinary},
+		{value: "json", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			buildSettings := serialized.Object{}
+			if tt.value != "" {
+				buildSettings["INFOPLIST_OUTPUT_FORMAT"] = tt.value
+			}
+
+			got, err := informationPropertyListOutputFormat(buildSettings)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// pbxprojWithBinaryInfoPlistOutput writes the App target's Info.plist in binary format.
+var pbxprojWithBinaryInfoPlistOutput = newFixtureReplacer(
+	`				INFOPLIST_FILE = App/Info.plist;
+`, `				INFOPLIST_FILE = App/Info.plist;
+				INFOPLIST_OUTPUT_FORMAT = binary;
+`,
+).Replace(pbxprojWithBuildFiles)
+
+func TestXcodeProj_AllInfoPlistPaths(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithGeneratedInfoPlist))
+	require.NoError(t, err)
+	project.Path = "/Users/bitrise/App/App.xcodeproj"
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(*project))
+
+	paths, err := project.AllInfoPlistPaths("Release")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"App": "/Users/bitrise/App/App/Info.plist",
+		"Kit": "/Users/bitrise/App/Kit/Info.plist",
+	}, paths)
+
+	paths, err = project.AllInfoPlistPaths("Missing")
+	require.NoError(t, err)
+	require.Empty(t, paths)
+}
+
+func TestXcodeProj_TargetGeneratesInfoPlist(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithGeneratedInfoPlist))
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(*project))
+
+	tests := []struct {
+		target string
+		want   bool
+	}{
+		{target: "App", want: false},
+		{target: "AppTests", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			got, err := project.TargetGeneratesInfoPlist(tt.target, "Debug")
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err = project.TargetGeneratesInfoPlist("Missing", "Debug")
+	require.Error(t, err)
+}
+
+// pbxprojWithGeneratedInfoPlist extends pbxprojWithTestTargets with the AppTests target
+// using a generated Info.plist; the App and Kit targets have an Info.plist file, AppUITests has none.
+var pbxprojWithGeneratedInfoPlist = newFixtureReplacer(
+	`				BUNDLE_LOADER = "$(TEST_HOST)";
+`, `				BUNDLE_LOADER = "$(TEST_HOST)";
+				GENERATE_INFOPLIST_FILE = YES;
+`,
+).Replace(pbxprojWithTestTargets)
+
+const arbitraryLoadsInfoPlist = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>NSAppTransportSecurity</key>
+	<dict>
+		<key>NSAllowsArbitraryLoads</key>
+		<true/>
+		<key>NSExceptionDomains</key>
+		<dict>
+			<key>example.com</key>
+			<dict>
+				<key>NSExceptionAllowsInsecureHTTPLoads</key>
+				<true/>
+				<key>NSExceptionMinimumTLSVersion</key>
+				<string>TLSv1.0</string>
+				<key>NSIncludesSubdomains</key>
+				<true/>
+			</dict>
+		</dict>
+	</dict>
+</dict>
+</plist>
+`
+
+const orientationsInfoPlist = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>UISupportedInterfaceOrientations</key>
+	<array>
+		<string>UIInterfaceOrientationPortrait</string>
+		<string>$(LANDSCAPE_ORIENTATION)</string>
+	</array>
+	<key>UISupportedInterfaceOrientations~ipad</key>
+	<array>
+		<string>UIInterfaceOrientationPortrait</string>
+		<string>UIInterfaceOrientationPortraitUpsideDown</string>
+	</array>
+</dict>
+</plist>
+`
+
+const requiredDeviceCapabilitiesListInfoPlist = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>UIRequiredDeviceCapabilities</key>
+	<array>
+		<string>arm64</string>
+		<string>nfc</string>
+	</array>
+</dict>
+</plist>
+`
+
+const requiredDeviceCapabilitiesDictionaryInfoPlist = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>UIRequiredDeviceCapabilities</key>
+	<dict>
+		<key>arm64</key>
+		<true/>
+		<key>metal</key>
+		<true/>
+		<key>telephony</key>
+		<false/>
+	</dict>
+</dict>
+</plist>
+`
+
+const storyboardAppInfoPlist = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>CFBundleIdentifier</key>
+	<string>$(PRODUCT_BUNDLE_IDENTIFIER)</string>
+	<key>UILaunchStoryboardName</key>
+	<string>LaunchScreen</string>
+	<key>UIMainStoryboardFile</key>
+	<string>Main</string>
+</dict>
+</plist>
+`
+
+const sceneStoryboardAppInfoPlist = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>UIApplicationSceneManifest</key>
+	<dict>
+		<key>UIApplicationSupportsMultipleScenes</key>
+		<false/>
+		<key>UISceneConfigurations</key>
+		<dict>
+			<key>UIWindowSceneSessionRoleApplication</key>
+			<array>
+				<dict>
+					<key>UISceneConfigurationName</key>
+					<string>Default Configuration</string>
+					<key>UISceneDelegateClassName</key>
+					<string>$(PRODUCT_MODULE_NAME).SceneDelegate</string>
+					<key>UISceneStoryboardFile</key>
+					<string>Main</string>
+				</dict>
+			</array>
+		</dict>
+	</dict>
+	<key>UILaunchStoryboardName</key>
+	<string>LaunchScreen</string>
+</dict>
+</plist>
+`
+
+const swiftUIAppInfoPlist = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>UIApplicationSceneManifest</key>
+	<dict>
+		<key>UIApplicationSupportsMultipleScenes</key>
+		<true/>
+	</dict>
+	<key>UILaunchScreen</key>
+	<dict/>
+</dict>
+</plist>
+`
+
+const widgetExtensionInfoPlist = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>NSExtension</key>
+	<dict>
+		<key>NSExtensionPointIdentifier</key>
+		<string>com.apple.widgetkit-extension</string>
+	</dict>
+</dict>
+</plist>
+`
+
+const notificationServiceExtensionInfoPlist = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>NSExtension</key>
+	<dict>
+		<key>NSExtensionPointIdentifier</key>
+		<string>com.apple.usernotifications.service</string>
+		<key>NSExtensionPrincipalClass</key>
+		<string>$(PRODUCT_MODULE_NAME).NotificationService</string>
+	</dict>
+</dict>
+</plist>
+`
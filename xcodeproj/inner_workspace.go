@@ -0,0 +1,33 @@
+package xcodeproj
+
+import (
+	"path/filepath"
+
+	"github.com/bitrise-io/go-utils/pathutil"
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// InnerWorkspacePath returns the path of the project.xcworkspace embedded in the project,
+// which holds the project's workspace settings and Swift package resolution.
+func (p XcodeProj) InnerWorkspacePath() string {
+	return filepath.Join(p.Path, "project.xcworkspace")
+}
+
+// InnerWorkspaceSettings returns the shared workspace settings of the project's inner workspace
+// (xcshareddata/WorkspaceSettings.xcsettings), like BuildSystemType.
+// If the settings file does not exist, nil is returned.
+func (p XcodeProj) InnerWorkspaceSettings() (serialized.Object, error) {
+	if err := p.checkOnDisk(); err != nil {
+		return nil, err
+	}
+
+	pth := filepath.Join(p.InnerWorkspacePath(), "xcshareddata", "WorkspaceSettings.xcsettings")
+	if exist, err := pathutil.IsPathExists(pth); err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, nil
+	}
+
+	settings, _, err := ReadPlistFile(pth)
+	return settings, err
+}
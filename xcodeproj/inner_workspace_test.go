@@ -0,0 +1,48 @@
+package xcodeproj
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_InnerWorkspace(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithBuildFiles, map[string]string{
+		"project.xcworkspace/xcshareddata/WorkspaceSettings.xcsettings": workspaceSettingsContent,
+		"project.xcworkspace/xcshareddata/swiftpm/Package.resolved":     packageResolvedV2,
+	})
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+
+	require.Equal(t, filepath.Join(projectPth, "project.xcworkspace"), project.InnerWorkspacePath())
+
+	settings, err := project.InnerWorkspaceSettings()
+	require.NoError(t, err)
+	require.Equal(t, serialized.Object{"BuildSystemType": "Original", "PreviewsEnabled": false}, settings)
+
+	packages, err := project.ResolvedSwiftPackages()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(packages))
+	require.Equal(t, "alamofire", packages[0].Identity)
+
+	project, err = Open(createTmpProject(t, "App.xcodeproj", pbxprojWithBuildFiles, nil))
+	require.NoError(t, err)
+
+	settings, err = project.InnerWorkspaceSettings()
+	require.NoError(t, err)
+	require.Nil(t, settings)
+}
+
+const workspaceSettingsContent = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>BuildSystemType</key>
+	<string>Original</string>
+	<key>PreviewsEnabled</key>
+	<false/>
+</dict>
+</plist>
+`
@@ -0,0 +1,99 @@
+package xcodeproj
+
+import (
+	"strings"
+)
+
+const (
+	weakFrameworkLinkerFlag = "-weak_framework"
+	objCLinkerFlag          = "-ObjC"
+	allLoadLinkerFlag       = "-all_load"
+	forceLoadLinkerFlag     = "-force_load"
+)
+
+// TargetWeakFrameworks returns the frameworks the target weak links (optional frameworks),
+// given as -weak_framework Name (or -Wl,-weak_framework,Name) pairs in the OTHER_LDFLAGS build setting.
+func (p XcodeProj) TargetWeakFrameworks(target, configuration string) ([]string, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	flags, err := buildSettingList(buildSettings, "OTHER_LDFLAGS")
+	if err != nil {
+		return nil, err
+	}
+
+	return weakFrameworks(flags), nil
+}
+
+func weakFrameworks(flags []string) []string {
+	flags = linkerFlags(flags)
+
+	var frameworks []string
+	for i := 0; i+1 < len(flags); i++ {
+		if flags[i] == weakFrameworkLinkerFlag {
+			i++
+			frameworks = append(frameworks, flags[i])
+		}
+	}
+	return frameworks
+}
+
+// TargetForceLoadLibraries returns the OTHER_LDFLAGS linker flags loading the members of the static libraries the target links,
+// which is required for the Objective-C categories of the libraries (otherwise calling them crashes with unrecognized selector):
+// whether -ObjC (load the members defining Objective-C classes or categories) and -all_load (load every member) are set,
+// and the libraries given with -force_load path (load every member of the library), with the build setting references resolved where possible.
+// The flags passed through the compiler driver (like -Wl,-force_load,path) are recognized too.
+func (p XcodeProj) TargetForceLoadLibraries(target, configuration string) (bool, bool, []string, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return false, false, nil, err
+	}
+
+	flags, err := buildSettingList(buildSettings, "OTHER_LDFLAGS")
+	if err != nil {
+		return false, false, nil, err
+	}
+
+	objCLoaded, allLoad, forceLoad := forceLoadFlags(flags)
+	for i, library := range forceLoad {
+		forceLoad[i] = resolveIfPossible(library, buildSettings)
+	}
+	return objCLoaded, allLoad, forceLoad, nil
+}
+
+func forceLoadFlags(flags []string) (bool, bool, []string) {
+	flags = linkerFlags(flags)
+
+	var objCLoaded, allLoad bool
+	var forceLoad []string
+	for i := 0; i < len(flags); i++ {
+		switch flags[i] {
+		case objCLinkerFlag:
+			objCLoaded = true
+		case allLoadLinkerFlag:
+			allLoad = true
+		case forceLoadLinkerFlag:
+			if i+1 < len(flags) {
+				i++
+				forceLoad = append(forceLoad, flags[i])
+			}
+		}
+	}
+	return objCLoaded, allLoad, forceLoad
+}
+
+// linkerFlags returns the linker flags of the OTHER_LDFLAGS entries, splitting the flags passed through
+// the compiler driver (like -Wl,-force_load,path) into separate flags.
+func linkerFlags(flags []string) []string {
+	var split []string
+	for _, flag := range flags {
+		if strings.HasPrefix(flag, "-Wl,") {
+			split = append(split, strings.Split(strings.TrimPrefix(flag, "-Wl,"), ",")...)
+		} else {
+			split = append(split, flag)
+		}
+	}
+	return split
+}
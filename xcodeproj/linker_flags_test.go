@@ -0,0 +1,124 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_weakFrameworks(t *testing.T) {
+	tests := []struct {
+		name  string
+		flags []string
+		want  []string
+	}{
+		{name: "no flags"},
+		{name: "hard linked only", flags: []string{"-ObjC", "-framework", "UIKit"}},
+		{name: "weak framework pairs", flags: []string{"-framework", "UIKit", "-weak_framework", "HealthKit", "-weak_framework", "ARKit"}, want: []string{"HealthKit", "ARKit"}},
+		{name: "linker pass-through", flags: []string{"-Wl,-weak_framework,CarPlay"}, want: []string{"CarPlay"}},
+		{name: "missing framework name", flags: []string{"-weak_framework"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, weakFrameworks(tt.flags))
+		})
+	}
+}
+
+func Test_linkerFlags(t *testing.T) {
+	require.Equal(t, []string{"-ObjC", "-force_load", "$(BUILT_PRODUCTS_DIR)/libKit.a", "-weak_framework", "CarPlay"},
+		linkerFlags([]string{"-ObjC", "-Wl,-force_load,$(BUILT_PRODUCTS_DIR)/libKit.a", "-weak_framework", "CarPlay"}))
+	require.Nil(t, linkerFlags(nil))
+}
+
+func TestXcodeProj_TargetWeakFrameworks(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithWeakFrameworks))
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(*project))
+
+	frameworks, err := project.TargetWeakFrameworks("App", "Release")
+	require.NoError(t, err)
+	require.Equal(t, []string{"HealthKit"}, frameworks)
+
+	frameworks, err = project.TargetWeakFrameworks("Kit", "Release")
+	require.NoError(t, err)
+	require.Empty(t, frameworks)
+}
+
+// pbxprojWithWeakFrameworks weak links the HealthKit framework in the App target's configurations.
+var pbxprojWithWeakFrameworks = newFixtureReplacer(
+	`				INFOPLIST_FILE = App/Info.plist;
+`, `				INFOPLIST_FILE = App/Info.plist;
+				OTHER_LDFLAGS = (
+					"$(inherited)",
+					"-ObjC",
+					"-framework",
+					UIKit,
+					"-weak_framework",
+					HealthKit,
+				);
+`,
+).Replace(pbxprojWithBuildFiles)
+
+func Test_forceLoadFlags(t *testing.T) {
+	tests := []struct {
+		name          string
+		flags         []string
+		wantObjC      bool
+		wantAllLoad   bool
+		wantForceLoad []string
+	}{
+		{name: "no flags"},
+		{name: "ObjC", flags: []string{"-ObjC", "-framework", "UIKit"}, wantObjC: true},
+		{name: "all load", flags: []string{"-all_load"}, wantAllLoad: true},
+		{name: "force load", flags: []string{"-force_load", "libA.a", "-force_load", "libB.a"}, wantForceLoad: []string{"libA.a", "libB.a"}},
+		{name: "linker pass-through", flags: []string{"-Wl,-ObjC", "-Wl,-force_load,libA.a"}, wantObjC: true, wantForceLoad: []string{"libA.a"}},
+		{name: "missing library path", flags: []string{"-force_load"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objCLoaded, allLoad, forceLoad := forceLoadFlags(tt.flags)
+			require.Equal(t, tt.wantObjC, objCLoaded)
+			require.Equal(t, tt.wantAllLoad, allLoad)
+			require.Equal(t, tt.wantForceLoad, forceLoad)
+		})
+	}
+}
+
+func TestXcodeProj_TargetForceLoadLibraries(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithForceLoadedLibraries))
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(*project))
+
+	objCLoaded, allLoad, forceLoad, err := project.TargetForceLoadLibraries("App", "Release")
+	require.NoError(t, err)
+	require.True(t, objCLoaded)
+	require.False(t, allLoad)
+	require.Equal(t, []string{"Vendor/libAnalytics.a", "$(BUILT_PRODUCTS_DIR)/libKit.a"}, forceLoad)
+
+	objCLoaded, allLoad, forceLoad, err = project.TargetForceLoadLibraries("Kit", "Release")
+	require.NoError(t, err)
+	require.False(t, objCLoaded)
+	require.True(t, allLoad)
+	require.Empty(t, forceLoad)
+}
+
+// pbxprojWithForceLoadedLibraries loads the Objective-C members of the App target's static libraries
+// and force loads the vendored analytics and the built Kit libraries. The Kit target loads every library member.
+var pbxprojWithForceLoadedLibraries = newFixtureReplacer(
+	`				INFOPLIST_FILE = App/Info.plist;
+`, `				INFOPLIST_FILE = App/Info.plist;
+				OTHER_LDFLAGS = (
+					"$(inherited)",
+					"-ObjC",
+					"-force_load",
+					"$(VENDOR_DIR)/libAnalytics.a",
+					"-Wl,-force_load,$(BUILT_PRODUCTS_DIR)/libKit.a",
+				);
+				VENDOR_DIR = Vendor;
+`,
+	`				INFOPLIST_FILE = Kit/Info.plist;
+`, `				INFOPLIST_FILE = Kit/Info.plist;
+				OTHER_LDFLAGS = "-all_load";
+`,
+).Replace(pbxprojWithBuildFiles)
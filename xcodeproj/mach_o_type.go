@@ -0,0 +1,66 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// MACH_O_TYPE values
+const (
+	MachOExecutable     = "mh_execute"
+	MachODynamicLibrary = "mh_dylib"
+	MachOBundle         = "mh_bundle"
+	MachOStaticLibrary  = "staticlib"
+	MachOObject         = "mh_object"
+)
+
+// defaultMachOTypes maps the product types to the MACH_O_TYPE Xcode uses if the build setting is not set.
+var defaultMachOTypes = map[string]string{
+	appProductType:                                 MachOExecutable,
+	appClipProductType:                             MachOExecutable,
+	"com.apple.product-type.app-extension":         MachOExecutable,
+	"com.apple.product-type.application.watchapp2": MachOExecutable,
+	"com.apple.product-type.watchkit2-extension":   MachOExecutable,
+	"com.apple.product-type.tool":                  MachOExecutable,
+	frameworkProductType:                           MachODynamicLibrary,
+	dynamicLibraryProductType:                      MachODynamicLibrary,
+	staticFrameworkProductType:                     MachOStaticLibrary,
+	staticLibraryProductType:                       MachOStaticLibrary,
+	"com.apple.product-type.bundle":                MachOBundle,
+	"com.apple.product-type.bundle.unit-test":      MachOBundle,
+	"com.apple.product-type.bundle.ui-testing":     MachOBundle,
+}
+
+// TargetMachOType returns the target's MACH_O_TYPE build setting (mh_execute, mh_dylib, mh_bundle, staticlib or mh_object).
+// If the build setting is not set, the default of the target's product type is returned.
+func (p XcodeProj) TargetMachOType(target, configuration string) (string, error) {
+	t, ok := p.Proj.TargetByName(target)
+	if !ok {
+		return "", fmt.Errorf("target not found: %s", target)
+	}
+
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return "", err
+	}
+
+	return machOType(buildSettings, t.ProductType)
+}
+
+func machOType(buildSettings serialized.Object, productType string) (string, error) {
+	value, err := buildSettings.String("MACH_O_TYPE")
+	if err != nil && !serialized.IsKeyNotFoundError(err) {
+		return "", err
+	}
+
+	if value = strings.TrimSpace(value); value != "" {
+		return value, nil
+	}
+
+	if value, ok := defaultMachOTypes[productType]; ok {
+		return value, nil
+	}
+	return "", fmt.Errorf("MACH_O_TYPE not set and no default known for product type: %s", productType)
+}
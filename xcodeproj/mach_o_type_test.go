@@ -0,0 +1,56 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_machOType(t *testing.T) {
+	tests := []struct {
+		name          string
+		buildSettings serialized.Object
+		productType   string
+		want          string
+		wantErr       bool
+	}{
+		{
+			name:        "app",
+			productType: "com.apple.product-type.application",
+			want:        MachOExecutable,
+		},
+		{
+			name:        "dynamic framework",
+			productType: "com.apple.product-type.framework",
+			want:        MachODynamicLibrary,
+		},
+		{
+			name:          "static framework",
+			buildSettings: serialized.Object{"MACH_O_TYPE": "staticlib"},
+			productType:   "com.apple.product-type.framework",
+			want:          MachOStaticLibrary,
+		},
+		{
+			name:        "static library",
+			productType: "com.apple.product-type.library.static",
+			want:        MachOStaticLibrary,
+		},
+		{
+			name:        "unknown product type",
+			productType: "com.example.product-type.custom",
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := machOType(tt.buildSettings, tt.productType)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
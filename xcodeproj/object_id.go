@@ -0,0 +1,25 @@
+package xcodeproj
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// newObjectID returns a random, 24 character long uppercase hexadecimal object id (like the ones Xcode generates),
+// which is not used by any of the objects.
+func newObjectID(objects serialized.Object) (string, error) {
+	for {
+		b := make([]byte, 12)
+		if _, err := rand.Read(b); err != nil {
+			return "", err
+		}
+
+		id := strings.ToUpper(hex.EncodeToString(b))
+		if _, ok := objects[id]; !ok {
+			return id, nil
+		}
+	}
+}
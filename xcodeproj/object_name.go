@@ -0,0 +1,52 @@
+package xcodeproj
+
+import (
+	"regexp"
+)
+
+// objectAnnotationRegexp matches an object id followed by its `/* Name */` annotation in an OpenStep ASCII pbxproj.
+var objectAnnotationRegexp = regexp.MustCompile(`\b([0-9A-F]{24}) /\* (.+?) \*/`)
+
+// parseObjectAnnotations returns the object id -> name map of the annotations found in the ASCII project.pbxproj content.
+// The first annotation of an id wins, as the object definition and its references are annotated the same way.
+func parseObjectAnnotations(content []byte) map[string]string {
+	annotations := map[string]string{}
+	for _, match := range objectAnnotationRegexp.FindAllSubmatch(content, -1) {
+		id := string(match[1])
+		if _, ok := annotations[id]; ok {
+			continue
+		}
+		annotations[id] = string(match[2])
+	}
+	return annotations
+}
+
+// ObjectName returns a human readable name of the object with the given id.
+// The original annotation of the project.pbxproj is preferred, otherwise the object's name or path is returned.
+// An empty string is returned if the object is not found.
+func (p XcodeProj) ObjectName(id string) string {
+	if name, ok := p.objectAnnotations[id]; ok {
+		return name
+	}
+
+	objects, err := p.RawProj.Object("objects")
+	if err != nil {
+		return ""
+	}
+	object, err := objects.Object(id)
+	if err != nil {
+		return ""
+	}
+
+	for _, key := range []string{"name", "path"} {
+		if name, err := object.String(key); err == nil && name != "" {
+			return name
+		}
+	}
+
+	isa, err := object.String("isa")
+	if err != nil {
+		return ""
+	}
+	return isa
+}
@@ -0,0 +1,30 @@
+package xcodeproj
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_ObjectName(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithouthTargetAttributes))
+	require.NoError(t, err)
+
+	require.Equal(t, "Target", project.ObjectName("13BD62FD256BE6D000F72361"))
+	require.Equal(t, "Project object", project.ObjectName("13BD62F6256BE6D000F72361"))
+	require.Equal(t, `Build configuration list for PBXNativeTarget "TargetWithouthTargetAttributes"`, project.ObjectName("13BD633A256BE7BF00F72361"))
+	require.Equal(t, "Target.app", project.ObjectName("13BD62FE256BE6D000F72361"))
+	require.Equal(t, "", project.ObjectName("000000000000000000000000"))
+}
+
+func TestXcodeProj_ObjectName_WithoutAnnotations(t *testing.T) {
+	content := regexp.MustCompile(` /\* .+? \*/`).ReplaceAllString(pbxprojWithouthTargetAttributes, "")
+	project, err := parsePBXProjContent([]byte(content))
+	require.NoError(t, err)
+
+	require.Equal(t, "Target", project.ObjectName("13BD62FD256BE6D000F72361"))
+	require.Equal(t, "Target.app", project.ObjectName("13BD62FE256BE6D000F72361"))
+	require.Equal(t, "Debug", project.ObjectName("13BD6324256BE6D300F72361"))
+	require.Equal(t, "PBXProject", project.ObjectName("13BD62F6256BE6D000F72361"))
+}
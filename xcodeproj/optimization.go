@@ -0,0 +1,158 @@
+package xcodeproj
+
+import (
+	"strings"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// Optimization build settings
+const (
+	gccOptimizationLevelKey   = "GCC_OPTIMIZATION_LEVEL"
+	swiftOptimizationLevelKey = "SWIFT_OPTIMIZATION_LEVEL"
+	swiftCompilationModeKey   = "SWIFT_COMPILATION_MODE"
+)
+
+// SWIFT_COMPILATION_MODE build setting values
+const (
+	SwiftCompilationModeSingleFile  = "singlefile"
+	SwiftCompilationModeWholeModule = "wholemodule"
+)
+
+// debugOptimizationDefaults and releaseOptimizationDefaults are the optimization build settings
+// of the Debug and Release configurations of Xcode's project templates.
+var (
+	debugOptimizationDefaults = map[string]string{
+		gccOptimizationLevelKey:   "0",
+		swiftOptimizationLevelKey: "-Onone",
+		swiftCompilationModeKey:   SwiftCompilationModeSingleFile,
+	}
+	releaseOptimizationDefaults = map[string]string{
+		gccOptimizationLevelKey:   "s",
+		swiftOptimizationLevelKey: "-O",
+		swiftCompilationModeKey:   SwiftCompilationModeWholeModule,
+	}
+)
+
+// TargetGCCOptimizationLevel returns the target's GCC_OPTIMIZATION_LEVEL build setting (like 0 or s).
+// If the build setting is not set, the default is inferred from the configuration name: 0 for debug configurations, s otherwise.
+func (p XcodeProj) TargetGCCOptimizationLevel(target, configuration string) (string, error) {
+	return p.targetOptimizationBuildSetting(target, configuration, gccOptimizationLevelKey)
+}
+
+// TargetSwiftOptimizationLevel returns the target's SWIFT_OPTIMIZATION_LEVEL build setting (like -Onone or -O).
+// If the build setting is not set, the default is inferred from the configuration name: -Onone for debug configurations, -O otherwise.
+func (p XcodeProj) TargetSwiftOptimizationLevel(target, configuration string) (string, error) {
+	return p.targetOptimizationBuildSetting(target, configuration, swiftOptimizationLevelKey)
+}
+
+// TargetSwiftCompilationMode returns the target's SWIFT_COMPILATION_MODE build setting (singlefile or wholemodule).
+// If the build setting is not set, the default is inferred from the configuration name: singlefile for debug configurations, wholemodule otherwise.
+func (p XcodeProj) TargetSwiftCompilationMode(target, configuration string) (string, error) {
+	return p.targetOptimizationBuildSetting(target, configuration, swiftCompilationModeKey)
+}
+
+func (p XcodeProj) targetOptimizationBuildSetting(target, configuration, key string) (string, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return "", err
+	}
+
+	return optimizationBuildSetting(buildSettings, key, configuration)
+}
+
+func optimizationBuildSetting(buildSettings serialized.Object, key, configuration string) (string, error) {
+	value, found, err := resolvedBuildSetting(buildSettings, key)
+	if err != nil {
+		return "", err
+	} else if found && value != "" {
+		return value, nil
+	}
+
+	if isDebugConfiguration(configuration) {
+		return debugOptimizationDefaults[key], nil
+	}
+	return releaseOptimizationDefaults[key], nil
+}
+
+// AuditReleaseOptimizations checks the release (non debug) configurations of the project's native targets
+// and returns a warning for each debug optimization setting found: GCC_OPTIMIZATION_LEVEL = 0,
+// SWIFT_OPTIMIZATION_LEVEL = -Onone and SWIFT_COMPILATION_MODE = singlefile.
+func (p XcodeProj) AuditReleaseOptimizations() ([]Warning, error) {
+	return p.auditReleaseOptimizationSettings(gccOptimizationLevelKey, swiftOptimizationLevelKey, swiftCompilationModeKey)
+}
+
+// AuditReleaseSwiftCompilationMode checks the release (non debug) configurations of the project's native targets
+// and returns a warning for each of them compiling Swift file by file (SWIFT_COMPILATION_MODE = singlefile)
+// instead of whole module.
+func (p XcodeProj) AuditReleaseSwiftCompilationMode() ([]Warning, error) {
+	return p.auditReleaseOptimizationSettings(swiftCompilationModeKey)
+}
+
+// auditReleaseOptimizationSettings returns a warning for each of the optimization build settings
+// set to its debug default in a release configuration.
+func (p XcodeProj) auditReleaseOptimizationSettings(keys ...string) ([]Warning, error) {
+	return p.auditReleaseConfigurations(func(target, configuration string, buildSettings serialized.Object) ([]Warning, error) {
+		var warnings []Warning
+		for _, key := range keys {
+			value, err := optimizationBuildSetting(buildSettings, key, configuration)
+			if err != nil {
+				return nil, err
+			}
+			if value != debugOptimizationDefaults[key] {
+				continue
+			}
+
+			warnings = append(warnings, Warning{
+				Target:        target,
+				Configuration: configuration,
+				BuildSetting:  key,
+				Value:         value,
+				Message:       "debug optimization in release configuration",
+			})
+		}
+		return warnings, nil
+	})
+}
+
+// auditReleaseConfigurations runs the audit on the build settings of each release (non debug) configuration
+// of the project's native targets and collects the warnings.
+func (p XcodeProj) auditReleaseConfigurations(audit func(target, configuration string, buildSettings serialized.Object) ([]Warning, error)) ([]Warning, error) {
+	return p.auditConfigurations(func(configuration string) bool {
+		return !isDebugConfiguration(configuration)
+	}, audit)
+}
+
+// auditConfigurations runs the audit on the build settings of each configuration of the project's native targets
+// accepted by the filter, and collects the warnings.
+func (p XcodeProj) auditConfigurations(filter func(configuration string) bool, audit func(target, configuration string, buildSettings serialized.Object) ([]Warning, error)) ([]Warning, error) {
+	var warnings []Warning
+	for _, target := range p.Proj.Targets {
+		if target.Type != NativeTargetType {
+			continue
+		}
+
+		for _, buildConfiguration := range target.BuildConfigurationList.BuildConfigurations {
+			if !filter(buildConfiguration.Name) {
+				continue
+			}
+
+			buildSettings, err := p.TargetBuildSettings(target.Name, buildConfiguration.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			configurationWarnings, err := audit(target.Name, buildConfiguration.Name, buildSettings)
+			if err != nil {
+				return nil, err
+			}
+			warnings = append(warnings, configurationWarnings...)
+		}
+	}
+	return warnings, nil
+}
+
+// isDebugConfiguration reports whether the configuration is meant for debugging, based on its name (like Debug or Debug-Staging).
+func isDebugConfiguration(configuration string) bool {
+	return strings.Contains(strings.ToLower(configuration), "debug")
+}
@@ -0,0 +1,167 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_optimizationBuildSetting(t *testing.T) {
+	tests := []struct {
+		name          string
+		buildSettings serialized.Object
+		key           string
+		configuration string
+		want          string
+	}{
+		{name: "debug default gcc level", key: gccOptimizationLevelKey, configuration: "Debug", want: "0"},
+		{name: "release default gcc level", key: gccOptimizationLevelKey, configuration: "Release", want: "s"},
+		{name: "custom debug configuration", key: swiftOptimizationLevelKey, configuration: "Staging-debug", want: "-Onone"},
+		{name: "custom release configuration", key: swiftOptimizationLevelKey, configuration: "AppStore", want: "-O"},
+		{name: "debug default compilation mode", key: swiftCompilationModeKey, configuration: "Debug", want: "singlefile"},
+		{name: "release default compilation mode", key: swiftCompilationModeKey, configuration: "Release", want: "wholemodule"},
+		{
+			name:          "set by build setting",
+			buildSettings: serialized.Object{swiftOptimizationLevelKey: "-Osize"},
+			key:           swiftOptimizationLevelKey,
+			configuration: "Release",
+			want:          "-Osize",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := optimizationBuildSetting(tt.buildSettings, tt.key, tt.configuration)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestXcodeProj_TargetOptimizationLevels(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithBuildFiles))
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(func(target, configuration string) (serialized.Object, error) {
+		for _, buildConfiguration := range project.Proj.BuildConfigurationList.BuildConfigurations {
+			if buildConfiguration.Name == configuration {
+				return buildConfiguration.BuildSettings, nil
+			}
+		}
+		return nil, nil
+	})
+
+	tests := []struct {
+		configuration              string
+		wantGCCOptimizationLevel   string
+		wantSwiftOptimizationLevel string
+		wantSwiftCompilationMode   string
+	}{
+		{configuration: "Debug", wantGCCOptimizationLevel: "0", wantSwiftOptimizationLevel: "-Onone", wantSwiftCompilationMode: "singlefile"},
+		{configuration: "Release", wantGCCOptimizationLevel: "s", wantSwiftOptimizationLevel: "-O", wantSwiftCompilationMode: "wholemodule"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.configuration, func(t *testing.T) {
+			gccOptimizationLevel, err := project.TargetGCCOptimizationLevel("App", tt.configuration)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantGCCOptimizationLevel, gccOptimizationLevel)
+
+			swiftOptimizationLevel, err := project.TargetSwiftOptimizationLevel("App", tt.configuration)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantSwiftOptimizationLevel, swiftOptimizationLevel)
+
+			swiftCompilationMode, err := project.TargetSwiftCompilationMode("App", tt.configuration)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantSwiftCompilationMode, swiftCompilationMode)
+		})
+	}
+}
+
+func TestXcodeProj_AuditReleaseOptimizations(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithDebugOptimizedRelease))
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(func(target, configuration string) (serialized.Object, error) {
+		buildSettings := serialized.Object{}
+		for _, buildConfiguration := range project.Proj.BuildConfigurationList.BuildConfigurations {
+			if buildConfiguration.Name == configuration {
+				for key, value := range buildConfiguration.BuildSettings {
+					buildSettings[key] = value
+				}
+			}
+		}
+		buildConfigurations, err := project.targetBuildConfigurations(target, configuration)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range buildConfigurations[0].BuildSettings {
+			buildSettings[key] = value
+		}
+		return buildSettings, nil
+	})
+
+	warnings, err := project.AuditReleaseOptimizations()
+	require.NoError(t, err)
+	require.Equal(t, []Warning{
+		{
+			Target:        "Kit",
+			Configuration: "Release",
+			BuildSetting:  swiftOptimizationLevelKey,
+			Value:         "-Onone",
+			Message:       "debug optimization in release configuration",
+		},
+		{
+			Target:        "Kit",
+			Configuration: "Release",
+			BuildSetting:  swiftCompilationModeKey,
+			Value:         "singlefile",
+			Message:       "debug optimization in release configuration",
+		},
+	}, warnings)
+	require.Equal(t, "Kit (Release): SWIFT_OPTIMIZATION_LEVEL = -Onone: debug optimization in release configuration", warnings[0].String())
+}
+
+func TestXcodeProj_AuditReleaseSwiftCompilationMode(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithSingleFileRelease))
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(*project))
+
+	mode, err := project.TargetSwiftCompilationMode("App", "Release")
+	require.NoError(t, err)
+	require.Equal(t, SwiftCompilationModeSingleFile, mode)
+
+	mode, err = project.TargetSwiftCompilationMode("Kit", "Release")
+	require.NoError(t, err)
+	require.Equal(t, SwiftCompilationModeWholeModule, mode)
+
+	warnings, err := project.AuditReleaseSwiftCompilationMode()
+	require.NoError(t, err)
+	require.Equal(t, []Warning{{
+		Target:        "App",
+		Configuration: "Release",
+		BuildSetting:  swiftCompilationModeKey,
+		Value:         SwiftCompilationModeSingleFile,
+		Message:       "debug optimization in release configuration",
+	}}, warnings)
+}
+
+// pbxprojWithSingleFileRelease sets single file Swift compilation in the App target's configurations.
+var pbxprojWithSingleFileRelease = newFixtureReplacer(
+	`				PRODUCT_NAME = "$(TARGET_NAME)";
+				TARGETED_DEVICE_FAMILY = "1,2";
+`, `				PRODUCT_NAME = "$(TARGET_NAME)";
+				SWIFT_COMPILATION_MODE = singlefile;
+				TARGETED_DEVICE_FAMILY = "1,2";
+`,
+).Replace(pbxprojWithBuildFiles)
+
+// pbxprojWithDebugOptimizedRelease overrides the Kit target's Release configuration with debug optimization settings.
+var pbxprojWithDebugOptimizedRelease = newFixtureReplacer(
+	`		E2B0F0752C8B4A0000A1B2C3 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+`, `		E2B0F0752C8B4A0000A1B2C3 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				SWIFT_COMPILATION_MODE = singlefile;
+				SWIFT_OPTIMIZATION_LEVEL = "-Onone";
+`,
+).Replace(pbxprojWithBuildFiles)
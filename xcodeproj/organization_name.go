@@ -0,0 +1,48 @@
+package xcodeproj
+
+import (
+	"fmt"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+const organizationNameAttribute = "ORGANIZATIONNAME"
+
+// TargetOrganizationName returns the organization name (used in the header of the created files) of the target:
+// the ORGANIZATIONNAME of the target's TargetAttributes, falling back to the project level ORGANIZATIONNAME attribute.
+// An empty name is returned if neither is set.
+func (p XcodeProj) TargetOrganizationName(targetName string) (string, error) {
+	target, ok := p.Proj.TargetByName(targetName)
+	if !ok {
+		return "", fmt.Errorf("target not found: %s", targetName)
+	}
+
+	attributes, err := p.Attributes()
+	if err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	targetAttributes, err := attributes.Object("TargetAttributes")
+	if err != nil && !serialized.IsKeyNotFoundError(err) {
+		return "", err
+	}
+
+	if targetAttributes != nil {
+		attributesOfTarget, err := targetAttributes.Object(target.ID)
+		if err != nil && !serialized.IsKeyNotFoundError(err) {
+			return "", err
+		}
+
+		if attributesOfTarget != nil {
+			organizationName, err := optionalString(attributesOfTarget, organizationNameAttribute)
+			if err != nil || organizationName != "" {
+				return organizationName, err
+			}
+		}
+	}
+
+	return optionalString(attributes, organizationNameAttribute)
+}
@@ -0,0 +1,53 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_TargetOrganizationName(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithOrganizationNames))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		target  string
+		want    string
+		wantErr bool
+	}{
+		{name: "project level", target: "App", want: "Bitrise"},
+		{name: "target override", target: "AppUITests", want: "Bitrise QA"},
+		{name: "missing target", target: "Missing", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := project.TargetOrganizationName(tt.target)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	project, err = parsePBXProjContent([]byte(pbxprojWithBuildFiles))
+	require.NoError(t, err)
+	organizationName, err := project.TargetOrganizationName("App")
+	require.NoError(t, err)
+	require.Equal(t, "", organizationName)
+}
+
+// pbxprojWithOrganizationNames extends pbxprojWithTestTargets with a project level organization name,
+// overridden by the AppUITests target.
+var pbxprojWithOrganizationNames = newFixtureReplacer(
+	`				LastUpgradeCheck = 1500;
+`, `				LastUpgradeCheck = 1500;
+				ORGANIZATIONNAME = Bitrise;
+`,
+	`						CreatedOnToolsVersion = 15.0;
+`, `						CreatedOnToolsVersion = 15.0;
+						ORGANIZATIONNAME = "Bitrise QA";
+`,
+).Replace(pbxprojWithTestTargets)
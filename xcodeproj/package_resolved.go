@@ -0,0 +1,124 @@
+package xcodeproj
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+	"github.com/bitrise-io/go-utils/pathutil"
+)
+
+// ResolvedPackage is a Swift package dependency pinned in a Package.resolved file.
+type ResolvedPackage struct {
+	Identity string
+	URL      string
+	Version  string
+	Branch   string
+	Revision string
+}
+
+type packageResolvedState struct {
+	Branch   string `json:"branch"`
+	Revision string `json:"revision"`
+	Version  string `json:"version"`
+}
+
+// packageResolved covers both the version 1 (object.pins) and the version 2 and later (pins) Package.resolved formats.
+type packageResolved struct {
+	Version int `json:"version"`
+	Object  struct {
+		Pins []struct {
+			Package       string               `json:"package"`
+			RepositoryURL string               `json:"repositoryURL"`
+			State         packageResolvedState `json:"state"`
+		} `json:"pins"`
+	} `json:"object"`
+	Pins []struct {
+		Identity string               `json:"identity"`
+		Kind     string               `json:"kind"`
+		Location string               `json:"location"`
+		State    packageResolvedState `json:"state"`
+	} `json:"pins"`
+}
+
+// ResolvedSwiftPackages returns the Swift packages pinned in the project's
+// project.xcworkspace/xcshareddata/swiftpm/Package.resolved file.
+// If the project has no Package.resolved file, no packages are returned.
+// Projects built through a standalone workspace keep the Package.resolved in the workspace,
+// see the xcworkspace package's Workspace.ResolvedSwiftPackages.
+func (p XcodeProj) ResolvedSwiftPackages() ([]ResolvedPackage, error) {
+	if err := p.checkOnDisk(); err != nil {
+		return nil, err
+	}
+
+	return ReadWorkspacePackageResolved(p.InnerWorkspacePath())
+}
+
+// ReadWorkspacePackageResolved returns the Swift packages pinned in the xcshareddata/swiftpm/Package.resolved file
+// of the workspace at workspacePth (a standalone .xcworkspace or the project.xcworkspace inside a project).
+// If the workspace has no Package.resolved file, no packages are returned.
+func ReadWorkspacePackageResolved(workspacePth string) ([]ResolvedPackage, error) {
+	pth := filepath.Join(workspacePth, "xcshareddata", "swiftpm", "Package.resolved")
+	if exist, err := pathutil.IsPathExists(pth); err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, nil
+	}
+
+	content, err := fileutil.ReadBytesFromFile(pth)
+	if err != nil {
+		return nil, err
+	}
+
+	packages, err := parsePackageResolved(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %s", pth, err)
+	}
+	return packages, nil
+}
+
+func parsePackageResolved(content []byte) ([]ResolvedPackage, error) {
+	var resolved packageResolved
+	if err := json.Unmarshal(content, &resolved); err != nil {
+		return nil, err
+	}
+
+	var packages []ResolvedPackage
+	switch {
+	case resolved.Version == 1:
+		for _, pin := range resolved.Object.Pins {
+			packages = append(packages, ResolvedPackage{
+				Identity: packageIdentity(pin.RepositoryURL),
+				URL:      pin.RepositoryURL,
+				Version:  pin.State.Version,
+				Branch:   pin.State.Branch,
+				Revision: pin.State.Revision,
+			})
+		}
+	case resolved.Version >= 2:
+		for _, pin := range resolved.Pins {
+			packages = append(packages, ResolvedPackage{
+				Identity: pin.Identity,
+				URL:      pin.Location,
+				Version:  pin.State.Version,
+				Branch:   pin.State.Branch,
+				Revision: pin.State.Revision,
+			})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported Package.resolved version: %d", resolved.Version)
+	}
+
+	return packages, nil
+}
+
+// packageIdentity returns the identity SwiftPM derives from the package's URL: its lowercased last path component without the .git extension.
+// **Example:** `https://github.com/Alamofire/Alamofire.git` **=>** `alamofire`
+func packageIdentity(url string) string {
+	identity := path.Base(strings.TrimSuffix(url, "/"))
+	identity = strings.TrimSuffix(identity, ".git")
+	return strings.ToLower(identity)
+}
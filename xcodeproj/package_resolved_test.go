@@ -0,0 +1,135 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parsePackageResolved(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []ResolvedPackage
+		wantErr bool
+	}{
+		{
+			name:    "version 1",
+			content: packageResolvedV1,
+			want: []ResolvedPackage{
+				{
+					Identity: "alamofire",
+					URL:      "https://github.com/Alamofire/Alamofire.git",
+					Version:  "5.8.1",
+					Revision: "3dc6a42c7727c49bf26508e29b0a0b35f9c7e1ad",
+				},
+				{
+					Identity: "swift-collections",
+					URL:      "https://github.com/apple/swift-collections",
+					Branch:   "main",
+					Revision: "94cf62b3ba8d4bed62680a282d4c25f9c63c2efb",
+				},
+			},
+		},
+		{
+			name:    "version 2",
+			content: packageResolvedV2,
+			want: []ResolvedPackage{
+				{
+					Identity: "alamofire",
+					URL:      "https://github.com/Alamofire/Alamofire.git",
+					Version:  "5.8.1",
+					Revision: "3dc6a42c7727c49bf26508e29b0a0b35f9c7e1ad",
+				},
+			},
+		},
+		{
+			name:    "unsupported version",
+			content: `{"version": 0}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			content: `{`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePackageResolved([]byte(tt.content))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestXcodeProj_ResolvedSwiftPackages(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithBuildFiles, map[string]string{
+		"project.xcworkspace/xcshareddata/swiftpm/Package.resolved": packageResolvedV2,
+	})
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+
+	packages, err := project.ResolvedSwiftPackages()
+	require.NoError(t, err)
+	require.Equal(t, []ResolvedPackage{{
+		Identity: "alamofire",
+		URL:      "https://github.com/Alamofire/Alamofire.git",
+		Version:  "5.8.1",
+		Revision: "3dc6a42c7727c49bf26508e29b0a0b35f9c7e1ad",
+	}}, packages)
+
+	project, err = Open(createTmpProject(t, "App.xcodeproj", pbxprojWithBuildFiles, nil))
+	require.NoError(t, err)
+
+	packages, err = project.ResolvedSwiftPackages()
+	require.NoError(t, err)
+	require.Nil(t, packages)
+}
+
+const packageResolvedV1 = `{
+  "object": {
+    "pins": [
+      {
+        "package": "Alamofire",
+        "repositoryURL": "https://github.com/Alamofire/Alamofire.git",
+        "state": {
+          "branch": null,
+          "revision": "3dc6a42c7727c49bf26508e29b0a0b35f9c7e1ad",
+          "version": "5.8.1"
+        }
+      },
+      {
+        "package": "swift-collections",
+        "repositoryURL": "https://github.com/apple/swift-collections",
+        "state": {
+          "branch": "main",
+          "revision": "94cf62b3ba8d4bed62680a282d4c25f9c63c2efb",
+          "version": null
+        }
+      }
+    ]
+  },
+  "version": 1
+}
+`
+
+const packageResolvedV2 = `{
+  "pins" : [
+    {
+      "identity" : "alamofire",
+      "kind" : "remoteSourceControl",
+      "location" : "https://github.com/Alamofire/Alamofire.git",
+      "state" : {
+        "revision" : "3dc6a42c7727c49bf26508e29b0a0b35f9c7e1ad",
+        "version" : "5.8.1"
+      }
+    }
+  ],
+  "version" : 2
+}
+`
@@ -0,0 +1,88 @@
+package xcodeproj
+
+import (
+	"strings"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// simulatorSDKs maps the device sdks to the corresponding simulator sdks.
+var simulatorSDKs = map[string]string{
+	"iphoneos":  "iphonesimulator",
+	"appletvos": "appletvsimulator",
+	"watchos":   "watchsimulator",
+	"xros":      "xrsimulator",
+}
+
+// macDeviceFamily is the TARGETED_DEVICE_FAMILY of Mac Catalyst targets, which does not have a simulator.
+const macDeviceFamily = "6"
+
+// TargetSupportsSimulator reports whether the target can be built for a simulator.
+// The SUPPORTED_PLATFORMS build setting is checked for a simulator platform (falling back to the SDKROOT's simulator),
+// and the TARGETED_DEVICE_FAMILY needs to contain a device family other than Mac.
+func (p XcodeProj) TargetSupportsSimulator(target, configuration string) (bool, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return false, err
+	}
+
+	return supportsSimulator(buildSettings)
+}
+
+func supportsSimulator(buildSettings serialized.Object) (bool, error) {
+	platforms, err := supportedPlatforms(buildSettings)
+	if err != nil {
+		return false, err
+	}
+
+	var simulatorPlatform bool
+	for _, platform := range platforms {
+		if strings.HasSuffix(platform, "simulator") {
+			simulatorPlatform = true
+			break
+		}
+	}
+	if !simulatorPlatform {
+		return false, nil
+	}
+
+	deviceFamily, err := buildSettings.String("TARGETED_DEVICE_FAMILY")
+	if err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	for _, family := range strings.Split(deviceFamily, ",") {
+		family = strings.TrimSpace(family)
+		if family != "" && family != macDeviceFamily {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// supportedPlatforms returns the platforms from the SUPPORTED_PLATFORMS build setting,
+// or the SDKROOT's device and simulator platforms if not set.
+func supportedPlatforms(buildSettings serialized.Object) ([]string, error) {
+	platforms, err := buildSettings.String("SUPPORTED_PLATFORMS")
+	if err == nil {
+		return strings.Fields(platforms), nil
+	} else if !serialized.IsKeyNotFoundError(err) {
+		return nil, err
+	}
+
+	sdk, err := buildSettings.String("SDKROOT")
+	if err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if simulatorSDK := simulatorSDKs[sdk]; simulatorSDK != "" {
+		return []string{sdk, simulatorSDK}, nil
+	}
+	return []string{sdk}, nil
+}
@@ -0,0 +1,63 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_supportsSimulator(t *testing.T) {
+	tests := []struct {
+		name          string
+		buildSettings serialized.Object
+		want          bool
+	}{
+		{
+			name: "universal iOS target",
+			buildSettings: serialized.Object{
+				"SUPPORTED_PLATFORMS":    "iphonesimulator iphoneos",
+				"TARGETED_DEVICE_FAMILY": "1,2",
+			},
+			want: true,
+		},
+		{
+			name: "device only iOS target",
+			buildSettings: serialized.Object{
+				"SUPPORTED_PLATFORMS":    "iphoneos",
+				"TARGETED_DEVICE_FAMILY": "1,2",
+			},
+			want: false,
+		},
+		{
+			name: "Mac Catalyst only device family",
+			buildSettings: serialized.Object{
+				"SUPPORTED_PLATFORMS":    "iphonesimulator iphoneos",
+				"TARGETED_DEVICE_FAMILY": "6",
+			},
+			want: false,
+		},
+		{
+			name: "tvOS target without supported platforms",
+			buildSettings: serialized.Object{
+				"SDKROOT": "appletvos",
+			},
+			want: true,
+		},
+		{
+			name: "macOS target",
+			buildSettings: serialized.Object{
+				"SDKROOT":             "macosx",
+				"SUPPORTED_PLATFORMS": "macosx",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := supportsSimulator(tt.buildSettings)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package xcodeproj
 
 import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
 	"io/ioutil"
 
 	"github.com/bitrise-io/go-plist"
@@ -8,6 +11,12 @@ import (
 	"github.com/bitrise-io/xcode-project/serialized"
 )
 
+const (
+	xmlPlistDocType = `<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">`
+	xmlPlistStart   = `<plist version="1.0">`
+	xmlPlistEnd     = `</plist>`
+)
+
 // ReadPlistFile returns a parsed object representing a plist file residing at path
 // and a format identifier specifying the plist file format.
 // Error is returned if:
@@ -51,3 +60,111 @@ func WritePlistFile(path string, entitlements serialized.Object, format int) err
 
 	return ioutil.WriteFile(path, marshalled, 0644)
 }
+
+// updatePlistFile reads the plist file at path, sets the given key-value pairs and writes it back,
+// preserving the file's format (XML, binary or OpenStep) and, for the text formats, its line endings.
+func updatePlistFile(path string, values serialized.Object) error {
+	return updatePlistFileInFormat(path, values, 0)
+}
+
+// updatePlistFileInFormat is updatePlistFile writing the file in the given format (like plist.BinaryFormat),
+// or preserving the file's format if the format is 0.
+func updatePlistFileInFormat(path string, values serialized.Object, format int) error {
+	content, err := fileutil.ReadBytesFromFile(path)
+	if err != nil {
+		return err
+	}
+
+	var object serialized.Object
+	inputFormat, err := plist.Unmarshal(content, &object)
+	if err != nil {
+		return err
+	}
+	if format == 0 {
+		format = inputFormat
+	}
+
+	for key, value := range values {
+		object[key] = value
+	}
+
+	marshalled, err := marshalPlist(object, format, bytes.Contains(content, []byte("\r\n")))
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, marshalled, 0644)
+}
+
+// marshalPlist marshals the object the way Xcode writes plist files: text formats are tab indented
+// (XML without indenting the root dict) and end with a newline.
+func marshalPlist(object serialized.Object, format int, crlf bool) ([]byte, error) {
+	if format == plist.BinaryFormat {
+		return plist.Marshal(object, format)
+	}
+
+	var marshalled []byte
+	var err error
+	if format == plist.XMLFormat {
+		marshalled, err = marshalXMLPlist(object)
+	} else {
+		marshalled, err = plist.MarshalIndent(object, format, "\t")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.HasSuffix(marshalled, []byte("\n")) {
+		marshalled = append(marshalled, '\n')
+	}
+	if crlf {
+		marshalled = bytes.ReplaceAll(marshalled, []byte("\n"), []byte("\r\n"))
+	}
+	return marshalled, nil
+}
+
+// marshalXMLPlist marshals the root dict's entries one by one, so that only the structure is indented
+// and the root dict's children end up on the first indentation level.
+func marshalXMLPlist(object serialized.Object) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	buf.WriteString(xmlPlistDocType + "\n")
+	buf.WriteString(xmlPlistStart + "\n")
+	if len(object) == 0 {
+		buf.WriteString("<dict/>\n")
+	} else {
+		buf.WriteString("<dict>\n")
+		for _, key := range sortedKeys(object) {
+			value, err := marshalXMLPlistValue(object[key])
+			if err != nil {
+				return nil, err
+			}
+
+			buf.WriteString("\t<key>")
+			if err := xml.EscapeText(&buf, []byte(key)); err != nil {
+				return nil, err
+			}
+			buf.WriteString("</key>\n")
+			buf.Write(value)
+			buf.WriteString("\n")
+		}
+		buf.WriteString("</dict>\n")
+	}
+	buf.WriteString(xmlPlistEnd + "\n")
+	return buf.Bytes(), nil
+}
+
+// marshalXMLPlistValue returns the value's XML, indented as a child of the plist element.
+func marshalXMLPlistValue(value interface{}) ([]byte, error) {
+	marshalled, err := plist.MarshalIndent(value, plist.XMLFormat, "\t")
+	if err != nil {
+		return nil, err
+	}
+
+	start := bytes.Index(marshalled, []byte(xmlPlistStart+"\n"))
+	end := bytes.LastIndex(marshalled, []byte("\n"+xmlPlistEnd))
+	if start == -1 || end < start {
+		return nil, fmt.Errorf("unexpected plist value: %s", marshalled)
+	}
+	return marshalled[start+len(xmlPlistStart)+1 : end], nil
+}
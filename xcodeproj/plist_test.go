@@ -0,0 +1,100 @@
+package xcodeproj
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bitrise-io/go-plist"
+	"github.com/bitrise-io/go-utils/fileutil"
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_marshalPlist(t *testing.T) {
+	object := serialized.Object{"com.apple.security.app-sandbox": true, "com.apple.security.application-groups": []interface{}{"group.io.bitrise.App"}}
+
+	content, err := marshalPlist(object, plist.XMLFormat, false)
+	require.NoError(t, err)
+	require.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>com.apple.security.app-sandbox</key>
+	<true/>
+	<key>com.apple.security.application-groups</key>
+	<array>
+		<string>group.io.bitrise.App</string>
+	</array>
+</dict>
+</plist>
+`, string(content))
+
+	content, err = marshalPlist(object, plist.XMLFormat, true)
+	require.NoError(t, err)
+	require.Equal(t, strings.Count(string(content), "\n"), strings.Count(string(content), "\r\n"))
+}
+
+func Test_updatePlistFile(t *testing.T) {
+	tests := []struct {
+		name   string
+		format int
+		crlf   bool
+	}{
+		{name: "xml", format: plist.XMLFormat},
+		{name: "xml with CRLF line endings", format: plist.XMLFormat, crlf: true},
+		{name: "binary", format: plist.BinaryFormat},
+		{name: "openstep", format: plist.OpenStepFormat},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original, err := marshalPlist(serialized.Object{"CFBundleVersion": "1"}, tt.format, tt.crlf)
+			require.NoError(t, err)
+
+			pth := filepath.Join(t.TempDir(), "Info.plist")
+			require.NoError(t, fileutil.WriteBytesToFile(pth, original))
+
+			require.NoError(t, updatePlistFile(pth, serialized.Object{"CFBundleVersion": "2"}))
+
+			object, format, err := ReadPlistFile(pth)
+			require.NoError(t, err)
+			require.Equal(t, tt.format, format)
+			require.Equal(t, serialized.Object{"CFBundleVersion": "2"}, object)
+
+			if tt.format != plist.BinaryFormat {
+				content, err := fileutil.ReadStringFromFile(pth)
+				require.NoError(t, err)
+				require.Equal(t, tt.crlf, strings.Contains(content, "\r\n"))
+			}
+		})
+	}
+}
+
+func Test_updatePlistFile_multilineString(t *testing.T) {
+	pth := filepath.Join(t.TempDir(), "Info.plist")
+	require.NoError(t, fileutil.WriteStringToFile(pth, `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>CFBundleVersion</key>
+	<string>1</string>
+	<key>NSHumanReadableCopyright</key>
+	<string>Copyright Bitrise
+	All rights reserved.</string>
+</dict>
+</plist>
+`))
+
+	require.NoError(t, updatePlistFile(pth, serialized.Object{"CFBundleVersion": "2"}))
+
+	object, _, err := ReadPlistFile(pth)
+	require.NoError(t, err)
+	require.Equal(t, serialized.Object{
+		"CFBundleVersion":          "2",
+		"NSHumanReadableCopyright": "Copyright Bitrise\n\tAll rights reserved.",
+	}, object)
+
+	content, err := fileutil.ReadStringFromFile(pth)
+	require.NoError(t, err)
+	require.Contains(t, content, "<dict>\n\t<key>CFBundleVersion</key>\n\t<string>2</string>\n")
+}
@@ -0,0 +1,23 @@
+package xcodeproj
+
+// TargetPreviewsEnabled reports whether SwiftUI previews are enabled (ENABLE_PREVIEWS = YES) for the target.
+// Other values (including the ones TargetBoolSetting rejects) count as disabled.
+func (p XcodeProj) TargetPreviewsEnabled(target, configuration string) (bool, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return false, err
+	}
+
+	value, _, err := resolvedBuildSetting(buildSettings, "ENABLE_PREVIEWS")
+	if err != nil {
+		return false, err
+	}
+	return value == "YES", nil
+}
+
+// SetTargetPreviewsEnabled sets the target's ENABLE_PREVIEWS build setting in the given configuration,
+// or in all of the target's configurations if the configuration is empty.
+// The project needs to be saved to persist the change.
+func (p XcodeProj) SetTargetPreviewsEnabled(target, configuration string, enabled bool) error {
+	return p.setTargetBuildSetting(target, configuration, "ENABLE_PREVIEWS", boolBuildSettingValue(enabled))
+}
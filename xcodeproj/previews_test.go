@@ -0,0 +1,57 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_SetTargetPreviewsEnabled(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithBuildFiles, nil)
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+
+	require.NoError(t, project.SetTargetPreviewsEnabled("App", "", true))
+	require.NoError(t, project.SetTargetPreviewsEnabled("App", "Release", false))
+	require.Error(t, project.SetTargetPreviewsEnabled("App", "Missing", true))
+	require.NoError(t, project.Save())
+
+	project, err = Open(projectPth)
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(project))
+
+	for configuration, want := range map[string]bool{"Debug": true, "Release": false} {
+		enabled, err := project.TargetPreviewsEnabled("App", configuration)
+		require.NoError(t, err)
+		require.Equal(t, want, enabled, configuration)
+	}
+
+	enabled, err := project.TargetPreviewsEnabled("Kit", "Debug")
+	require.NoError(t, err)
+	require.False(t, enabled)
+}
+
+func TestXcodeProj_TargetPreviewsEnabled_InvalidValue(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithBuildFiles))
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(func(target, configuration string) (serialized.Object, error) {
+		return serialized.Object{"ENABLE_PREVIEWS": "maybe"}, nil
+	})
+
+	enabled, err := project.TargetPreviewsEnabled("App", "Debug")
+	require.NoError(t, err)
+	require.False(t, enabled)
+}
+
+// rawBuildSettingsProvider provides the target's build settings as found in the project file,
+// without the project level and the default build settings.
+func rawBuildSettingsProvider(project XcodeProj) BuildSettingsProvider {
+	return func(target, configuration string) (serialized.Object, error) {
+		buildConfigurations, err := project.targetBuildConfigurations(target, configuration)
+		if err != nil {
+			return nil, err
+		}
+		return buildConfigurations[0].BuildSettings, nil
+	}
+}
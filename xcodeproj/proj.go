@@ -96,3 +96,29 @@ func (p Proj) TargetByName(name string) (Target, bool) {
 	}
 	return Target{}, false
 }
+
+// FilterTargets returns the project's targets matching the predicate.
+func (p Proj) FilterTargets(predicate func(Target) bool) []Target {
+	var targets []Target
+	for _, target := range p.Targets {
+		if predicate(target) {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
+
+// FrameworkTargets returns the project's framework targets.
+func (p Proj) FrameworkTargets() []Target {
+	return p.FilterTargets(Target.IsFramework)
+}
+
+// StaticLibraryTargets returns the project's static library targets.
+func (p Proj) StaticLibraryTargets() []Target {
+	return p.FilterTargets(Target.IsStaticLibrary)
+}
+
+// DynamicLibraryTargets returns the project's dynamic library targets.
+func (p Proj) DynamicLibraryTargets() []Target {
+	return p.FilterTargets(Target.IsDynamicLibrary)
+}
@@ -755,3 +755,17 @@ const expectedProj = `{
 		}
 	}
 }`
+
+func TestProj_FilterTargets(t *testing.T) {
+	app := Target{Name: "App", ProductType: "com.apple.product-type.application", ProductReference: ProductReference{Path: "App.app"}}
+	framework := Target{Name: "Kit", ProductType: "com.apple.product-type.framework"}
+	staticLibrary := Target{Name: "Core", ProductType: "com.apple.product-type.library.static"}
+	dynamicLibrary := Target{Name: "Plugin", ProductType: "com.apple.product-type.library.dynamic"}
+	proj := Proj{Targets: []Target{app, framework, staticLibrary, dynamicLibrary}}
+
+	require.Equal(t, []Target{framework}, proj.FrameworkTargets())
+	require.Equal(t, []Target{staticLibrary}, proj.StaticLibraryTargets())
+	require.Equal(t, []Target{dynamicLibrary}, proj.DynamicLibraryTargets())
+	require.Equal(t, []Target{app}, proj.FilterTargets(Target.IsAppProduct))
+	require.Nil(t, proj.FilterTargets(Target.IsTestProduct))
+}
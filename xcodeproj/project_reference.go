@@ -0,0 +1,238 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// ReferencedProjectPaths returns the absolute paths of the projects referenced by the project:
+// the subprojects (PBXProject projectReferences) and the containers of the cross-project dependencies (PBXContainerItemProxy).
+// Each path is returned once, in the order of first occurrence.
+func (p XcodeProj) ReferencedProjectPaths() ([]string, error) {
+	objects, err := p.RawProj.Object("objects")
+	if err != nil {
+		return nil, err
+	}
+
+	rawProject, err := objects.Object(p.Proj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var fileRefIDs []string
+
+	projectReferences, err := rawProjectReferences(rawProject)
+	if err != nil {
+		return nil, err
+	}
+	for _, reference := range projectReferences {
+		if projectRef, err := reference.String("ProjectRef"); err == nil {
+			fileRefIDs = append(fileRefIDs, projectRef)
+		}
+	}
+
+	for _, id := range sortedKeys(objects) {
+		object, err := objects.Object(id)
+		if err != nil {
+			return nil, err
+		}
+
+		if isa, err := object.String("isa"); err != nil || isa != "PBXContainerItemProxy" {
+			continue
+		}
+
+		containerPortal, err := object.String("containerPortal")
+		if err != nil {
+			return nil, err
+		}
+		if containerPortal != p.Proj.ID {
+			fileRefIDs = append(fileRefIDs, containerPortal)
+		}
+	}
+
+	var paths []string
+	visited := map[string]bool{}
+	for _, fileRefID := range fileRefIDs {
+		pth, err := p.fileReferenceAbsolutePath(fileRefID, objects)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve referenced project (%s) path: %s", fileRefID, err)
+		}
+
+		if !IsXcodeProj(pth) || visited[pth] {
+			continue
+		}
+		visited[pth] = true
+		paths = append(paths, pth)
+	}
+
+	return paths, nil
+}
+
+// ProjectReference is a subproject referenced by the project (an item of the PBXProject's projectReferences).
+type ProjectReference struct {
+	// ProjectRef is the id of the file reference pointing to the subproject.
+	ProjectRef string
+	// Path is the absolute path of the subproject.
+	Path string
+	// ProductGroup is the id of the group holding the proxies (PBXReferenceProxy) of the subproject's products.
+	ProductGroup string
+	// Products are the paths of the subproject's products in the product group, like libLib.a or Lib.framework.
+	Products []string
+}
+
+// ProjectReferences returns the subprojects referenced by the project, in the order of the PBXProject's projectReferences.
+func (p XcodeProj) ProjectReferences() ([]ProjectReference, error) {
+	objects, err := p.RawProj.Object("objects")
+	if err != nil {
+		return nil, err
+	}
+
+	rawProject, err := objects.Object(p.Proj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rawReferences, err := rawProjectReferences(rawProject)
+	if err != nil {
+		return nil, err
+	}
+
+	var references []ProjectReference
+	for _, rawReference := range rawReferences {
+		projectRef, err := rawReference.String("ProjectRef")
+		if err != nil {
+			return nil, err
+		}
+
+		pth, err := p.fileReferenceAbsolutePath(projectRef, objects)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve referenced project (%s) path: %s", projectRef, err)
+		}
+
+		productGroup, err := optionalString(rawReference, "ProductGroup")
+		if err != nil {
+			return nil, err
+		}
+
+		products, err := referenceProxyPaths(productGroup, objects)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read referenced project (%s) products: %s", pth, err)
+		}
+
+		references = append(references, ProjectReference{
+			ProjectRef:   projectRef,
+			Path:         pth,
+			ProductGroup: productGroup,
+			Products:     products,
+		})
+	}
+
+	return references, nil
+}
+
+// rawProjectReferences returns the items of the project's projectReferences.
+func rawProjectReferences(rawProject serialized.Object) ([]serialized.Object, error) {
+	projectReferences, err := rawProject.Value("projectReferences")
+	if err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	rawReferences, ok := projectReferences.([]interface{})
+	if !ok {
+		return nil, serialized.NewTypeCastError("projectReferences", projectReferences, []interface{}{})
+	}
+
+	var references []serialized.Object
+	for _, reference := range rawReferences {
+		if rawReference, ok := reference.(map[string]interface{}); ok {
+			references = append(references, rawReference)
+		}
+	}
+	return references, nil
+}
+
+// referenceProxyPaths returns the paths of the PBXReferenceProxy children of the product group.
+func referenceProxyPaths(productGroupID string, objects serialized.Object) ([]string, error) {
+	if productGroupID == "" {
+		return nil, nil
+	}
+
+	productGroup, err := objects.Object(productGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	children, err := productGroup.StringSlice("children")
+	if err != nil && !serialized.IsKeyNotFoundError(err) {
+		return nil, err
+	}
+
+	var paths []string
+	for _, id := range children {
+		child, err := objects.Object(id)
+		if err != nil {
+			return nil, err
+		}
+
+		if isa, err := child.String("isa"); err != nil {
+			return nil, err
+		} else if isa != "PBXReferenceProxy" {
+			continue
+		}
+
+		pth, err := child.String("path")
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, pth)
+	}
+	return paths, nil
+}
+
+// fileReferenceAbsolutePath returns the absolute path of the file reference.
+func (p XcodeProj) fileReferenceAbsolutePath(id string, objects serialized.Object) (string, error) {
+	if err := p.checkOnDisk(); err != nil {
+		return "", err
+	}
+
+	fileRef, err := objects.Object(id)
+	if err != nil {
+		return "", err
+	}
+
+	pth, err := fileRef.String("path")
+	if err != nil {
+		return "", err
+	}
+
+	sourceTree, err := fileRef.String("sourceTree")
+	if err != nil {
+		return "", err
+	}
+
+	switch sourceTree {
+	case "<absolute>":
+		return filepath.Clean(pth), nil
+	case "SOURCE_ROOT":
+		return filepath.Join(filepath.Dir(p.Path), pth), nil
+	default:
+		pth, err := resolveObjectAbsolutePath(id, p.Proj.ID, p.Path, objects)
+		if err != nil {
+			return "", err
+		}
+		return filepath.Clean(pth), nil
+	}
+}
+
+// sortedKeys returns the keys of the object in ascending order, for stable iteration.
+func sortedKeys(object serialized.Object) []string {
+	keys := object.Keys()
+	sort.Strings(keys)
+	return keys
+}
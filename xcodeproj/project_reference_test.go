@@ -0,0 +1,139 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_ProjectReferences(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithReferenceProxies))
+	require.NoError(t, err)
+	project.Path = "/work/App/App.xcodeproj"
+
+	references, err := project.ProjectReferences()
+	require.NoError(t, err)
+	require.Equal(t, []ProjectReference{
+		{
+			ProjectRef:   "E2B0F00B2C8B4A0000A1B2C3",
+			Path:         "/work/Lib/Lib.xcodeproj",
+			ProductGroup: "E2B0F0342C8B4A0000A1B2C3",
+			Products:     []string{"libLib.a"},
+		},
+	}, references)
+
+	project, err = parsePBXProjContent([]byte(pbxprojWithBuildFiles))
+	require.NoError(t, err)
+
+	references, err = project.ProjectReferences()
+	require.NoError(t, err)
+	require.Equal(t, []ProjectReference(nil), references)
+}
+
+func TestXcodeProj_ReferencedProjectPaths(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithProjectReferences))
+	require.NoError(t, err)
+	project.Path = "/work/App/App.xcodeproj"
+
+	paths, err := project.ReferencedProjectPaths()
+	require.NoError(t, err)
+	require.Equal(t, []string{"/work/Lib/Lib.xcodeproj", "/work/App/Tools/Tools.xcodeproj"}, paths)
+
+	project, err = parsePBXProjContent([]byte(pbxprojWithBuildFiles))
+	require.NoError(t, err)
+
+	paths, err = project.ReferencedProjectPaths()
+	require.NoError(t, err)
+	require.Equal(t, []string(nil), paths)
+}
+
+// pbxprojWithProjectReferences extends pbxprojWithBuildFiles with a subproject (../Lib/Lib.xcodeproj)
+// and a cross-project dependency on a SOURCE_ROOT relative project (Tools/Tools.xcodeproj).
+var pbxprojWithProjectReferences = newFixtureReplacer(
+	`/* Begin PBXCopyFilesBuildPhase section */`,
+	`/* Begin PBXContainerItemProxy section */
+		E2B0F0A02C8B4A0000A1B2C3 /* PBXContainerItemProxy */ = {
+			isa = PBXContainerItemProxy;
+			containerPortal = E2B0F00B2C8B4A0000A1B2C3 /* Lib.xcodeproj */;
+			proxyType = 2;
+			remoteGlobalIDString = F1A0F0012C8B4A0000A1B2C3;
+			remoteInfo = Lib;
+		};
+		E2B0F0A12C8B4A0000A1B2C3 /* PBXContainerItemProxy */ = {
+			isa = PBXContainerItemProxy;
+			containerPortal = E2B0F0502C8B4A0000A1B2C3 /* Project object */;
+			proxyType = 1;
+			remoteGlobalIDString = E2B0F0412C8B4A0000A1B2C3;
+			remoteInfo = Kit;
+		};
+		E2B0F0A22C8B4A0000A1B2C3 /* PBXContainerItemProxy */ = {
+			isa = PBXContainerItemProxy;
+			containerPortal = E2B0F00C2C8B4A0000A1B2C3 /* Tools.xcodeproj */;
+			proxyType = 1;
+			remoteGlobalIDString = F2A0F0012C8B4A0000A1B2C3;
+			remoteInfo = Tools;
+		};
+/* End PBXContainerItemProxy section */
+
+/* Begin PBXCopyFilesBuildPhase section */`,
+
+	`/* End PBXFileReference section */`,
+	`		E2B0F00B2C8B4A0000A1B2C3 /* Lib.xcodeproj */ = {isa = PBXFileReference; lastKnownFileType = "wrapper.pb-project"; name = Lib.xcodeproj; path = ../Lib/Lib.xcodeproj; sourceTree = "<group>"; };
+		E2B0F00C2C8B4A0000A1B2C3 /* Tools.xcodeproj */ = {isa = PBXFileReference; lastKnownFileType = "wrapper.pb-project"; path = Tools/Tools.xcodeproj; sourceTree = SOURCE_ROOT; };
+/* End PBXFileReference section */`,
+
+	`				E2B0F0332C8B4A0000A1B2C3 /* Products */,
+			);
+			sourceTree = "<group>";
+		};`,
+	`				E2B0F0332C8B4A0000A1B2C3 /* Products */,
+				E2B0F00B2C8B4A0000A1B2C3 /* Lib.xcodeproj */,
+			);
+			sourceTree = "<group>";
+		};
+		E2B0F0342C8B4A0000A1B2C3 /* Products */ = {
+			isa = PBXGroup;
+			children = (
+			);
+			name = Products;
+			sourceTree = "<group>";
+		};`,
+
+	`			projectDirPath = "";
+			projectRoot = "";`,
+	`			projectDirPath = "";
+			projectReferences = (
+				{
+					ProductGroup = E2B0F0342C8B4A0000A1B2C3 /* Products */;
+					ProjectRef = E2B0F00B2C8B4A0000A1B2C3 /* Lib.xcodeproj */;
+				},
+			);
+			projectRoot = "";`,
+).Replace(pbxprojWithBuildFiles)
+
+// pbxprojWithReferenceProxies extends pbxprojWithProjectReferences with the Lib subproject's libLib.a product
+// in the subproject's product group.
+var pbxprojWithReferenceProxies = newFixtureReplacer(
+	`/* Begin PBXResourcesBuildPhase section */`,
+	`/* Begin PBXReferenceProxy section */
+		E2B0F00F2C8B4A0000A1B2C3 /* libLib.a */ = {
+			isa = PBXReferenceProxy;
+			fileType = archive.ar;
+			path = libLib.a;
+			remoteRef = E2B0F0A02C8B4A0000A1B2C3 /* PBXContainerItemProxy */;
+			sourceTree = BUILT_PRODUCTS_DIR;
+		};
+/* End PBXReferenceProxy section */
+
+/* Begin PBXResourcesBuildPhase section */`,
+
+	`		E2B0F0342C8B4A0000A1B2C3 /* Products */ = {
+			isa = PBXGroup;
+			children = (
+			);`,
+	`		E2B0F0342C8B4A0000A1B2C3 /* Products */ = {
+			isa = PBXGroup;
+			children = (
+				E2B0F00F2C8B4A0000A1B2C3 /* libLib.a */,
+			);`,
+).Replace(pbxprojWithProjectReferences)
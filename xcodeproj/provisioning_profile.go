@@ -0,0 +1,87 @@
+package xcodeproj
+
+// ProvisioningProfileRef is the provisioning profile referenced by a target's build settings.
+// UUID is the PROVISIONING_PROFILE and Specifier is the PROVISIONING_PROFILE_SPECIFIER (profile name) build setting,
+// their sdk conditional variants (like PROVISIONING_PROFILE[sdk=iphoneos*]) are mapped by the sdk pattern (like iphoneos*).
+type ProvisioningProfileRef struct {
+	UUID          string
+	Specifier     string
+	SDKUUIDs      map[string]string
+	SDKSpecifiers map[string]string
+}
+
+// TargetProvisioningProfile returns the provisioning profile referenced by the target's configuration,
+// including the sdk conditional variants, as set in the project file.
+// Target level settings override the project level ones, an empty target level value clears the project level one.
+func (p XcodeProj) TargetProvisioningProfile(target, configuration string) (ProvisioningProfileRef, error) {
+	buildConfigurations, err := p.targetConfigurationLevels(target, configuration)
+	if err != nil {
+		return ProvisioningProfileRef{}, err
+	}
+
+	ref := ProvisioningProfileRef{SDKUUIDs: map[string]string{}, SDKSpecifiers: map[string]string{}}
+	set := map[string]bool{}
+	for _, buildConfiguration := range buildConfigurations {
+		for key, value := range buildConfiguration.BuildSettings {
+			name, conditions, ok := parseConditionalBuildSettingKey(key)
+			if !ok {
+				continue
+			}
+
+			s, ok := value.(string)
+			if !ok {
+				continue
+			}
+
+			var uuidOrSpecifier *string
+			var sdkValues map[string]string
+			switch name {
+			case "PROVISIONING_PROFILE":
+				uuidOrSpecifier, sdkValues = &ref.UUID, ref.SDKUUIDs
+			case "PROVISIONING_PROFILE_SPECIFIER":
+				uuidOrSpecifier, sdkValues = &ref.Specifier, ref.SDKSpecifiers
+			default:
+				continue
+			}
+
+			sdk, isSDKVariant := conditions["sdk"]
+			switch {
+			case len(conditions) == 0 && !set[name]:
+				*uuidOrSpecifier = s
+				set[name] = true
+			case len(conditions) == 1 && isSDKVariant:
+				if _, ok := sdkValues[sdk]; !ok {
+					sdkValues[sdk] = s
+				}
+			}
+		}
+	}
+
+	for _, sdkValues := range []map[string]string{ref.SDKUUIDs, ref.SDKSpecifiers} {
+		for sdk, s := range sdkValues {
+			if s == "" {
+				delete(sdkValues, sdk)
+			}
+		}
+	}
+
+	return ref, nil
+}
+
+// AllTargetProvisioningProfiles returns the provisioning profiles referenced by the project's targets in the given configuration,
+// mapped by the target name. Targets without the configuration are skipped.
+func (p XcodeProj) AllTargetProvisioningProfiles(configuration string) (map[string]ProvisioningProfileRef, error) {
+	refs := map[string]ProvisioningProfileRef{}
+	for _, target := range p.Proj.Targets {
+		if !target.hasConfiguration(configuration) {
+			continue
+		}
+
+		ref, err := p.TargetProvisioningProfile(target.Name, configuration)
+		if err != nil {
+			return nil, err
+		}
+		refs[target.Name] = ref
+	}
+	return refs, nil
+}
@@ -0,0 +1,74 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_AllTargetProvisioningProfiles(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithProvisioningProfiles))
+	require.NoError(t, err)
+
+	refs, err := project.AllTargetProvisioningProfiles("Release")
+	require.NoError(t, err)
+	require.Equal(t, map[string]ProvisioningProfileRef{
+		"App": {
+			Specifier:     "App Store io.bitrise.App",
+			SDKUUIDs:      map[string]string{"iphoneos*": "8d0a2c4e-6b3f-4c5d-9e7a-1f2b3c4d5e6f"},
+			SDKSpecifiers: map[string]string{"iphoneos*": "Project Distribution"},
+		},
+		"Kit": {
+			SDKUUIDs:      map[string]string{},
+			SDKSpecifiers: map[string]string{"iphoneos*": "Project Distribution"},
+		},
+	}, refs)
+
+	refs, err = project.AllTargetProvisioningProfiles("Debug")
+	require.NoError(t, err)
+	require.Equal(t, ProvisioningProfileRef{
+		UUID:          "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+		SDKUUIDs:      map[string]string{},
+		SDKSpecifiers: map[string]string{},
+	}, refs["App"])
+	require.Equal(t, ProvisioningProfileRef{
+		Specifier:     "Project Development",
+		SDKUUIDs:      map[string]string{},
+		SDKSpecifiers: map[string]string{},
+	}, refs["Kit"])
+
+	refs, err = project.AllTargetProvisioningProfiles("Missing")
+	require.NoError(t, err)
+	require.Empty(t, refs)
+}
+
+// pbxprojWithProvisioningProfiles references provisioning profiles in the App target's configurations,
+// a profile in the project's Debug configuration (cleared by the App target) and an sdk conditional profile in the project's Release configuration.
+var pbxprojWithProvisioningProfiles = newFixtureReplacer(
+	`				SWIFT_OPTIMIZATION_LEVEL = "-Onone";
+`, `				PROVISIONING_PROFILE_SPECIFIER = "Project Development";
+				SWIFT_OPTIMIZATION_LEVEL = "-Onone";
+`,
+	`				SWIFT_OPTIMIZATION_LEVEL = "-O";
+`, `				SWIFT_OPTIMIZATION_LEVEL = "-O";
+				"PROVISIONING_PROFILE_SPECIFIER[sdk=iphoneos*]" = "Project Distribution";
+`,
+	`				PRODUCT_NAME = "$(TARGET_NAME)";
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Debug;`, `				PRODUCT_NAME = "$(TARGET_NAME)";
+				PROVISIONING_PROFILE = "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d";
+				PROVISIONING_PROFILE_SPECIFIER = "";
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Debug;`,
+	`				PRODUCT_NAME = "$(TARGET_NAME)";
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Release;`, `				PRODUCT_NAME = "$(TARGET_NAME)";
+				"PROVISIONING_PROFILE[sdk=iphoneos*]" = "8d0a2c4e-6b3f-4c5d-9e7a-1f2b3c4d5e6f";
+				PROVISIONING_PROFILE_SPECIFIER = "App Store io.bitrise.App";
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Release;`,
+).Replace(pbxprojWithBuildFiles)
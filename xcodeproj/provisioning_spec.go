@@ -0,0 +1,105 @@
+package xcodeproj
+
+import (
+	"fmt"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// platformsBySDK maps the SDKROOT values to the provisioning profile platforms.
+var platformsBySDK = map[string]string{
+	"iphoneos":  "iOS",
+	"appletvos": "tvOS",
+	"watchos":   "watchOS",
+	"xros":      "visionOS",
+	"macosx":    "macOS",
+}
+
+// wildcardCompatibleEntitlements are the entitlements a wildcard App ID's provisioning profile can provide,
+// any other entitlement requires an explicit App ID.
+var wildcardCompatibleEntitlements = map[string]bool{
+	"application-identifier":                            true,
+	"com.apple.application-identifier":                  true,
+	"com.apple.developer.team-identifier":               true,
+	"keychain-access-groups":                            true,
+	"get-task-allow":                                    true,
+	"com.apple.security.get-task-allow":                 true,
+	"beta-reports-active":                               true,
+	"com.apple.security.app-sandbox":                    true,
+	"com.apple.security.network.client":                 true,
+	"com.apple.security.network.server":                 true,
+	"com.apple.security.files.user-selected.read-only":  true,
+	"com.apple.security.files.user-selected.read-write": true,
+}
+
+// ProvisioningSpec describes the provisioning profile requirements of a target.
+type ProvisioningSpec struct {
+	BundleID string
+	TeamID   string
+	// Platform is the provisioning profile platform: iOS, tvOS, watchOS, visionOS or macOS.
+	Platform     string
+	Entitlements serialized.Object
+	Capabilities map[string]bool
+	// WildcardEligible reports whether a wildcard App ID's profile can sign the target,
+	// which is the case if the target does not use entitlements or capabilities requiring an explicit App ID.
+	WildcardEligible bool
+}
+
+// TargetProvisioningSpec returns the target's provisioning profile requirements:
+// the resolved bundle ID, the DEVELOPMENT_TEAM, the platform of the SDKROOT, the resolved entitlements and capabilities.
+func (p XcodeProj) TargetProvisioningSpec(target, configuration string) (ProvisioningSpec, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return ProvisioningSpec{}, err
+	}
+
+	bundleID, err := p.bundleID(buildSettings)
+	if err != nil {
+		return ProvisioningSpec{}, fmt.Errorf("failed to read bundle ID: %s", err)
+	}
+
+	teamID, _, err := resolvedBuildSetting(buildSettings, "DEVELOPMENT_TEAM")
+	if err != nil {
+		return ProvisioningSpec{}, err
+	}
+
+	sdk, err := buildSettings.String("SDKROOT")
+	if err != nil && !serialized.IsKeyNotFoundError(err) {
+		return ProvisioningSpec{}, err
+	}
+
+	entitlements, err := p.entitlements(buildSettings)
+	if err != nil {
+		return ProvisioningSpec{}, fmt.Errorf("failed to read entitlements: %s", err)
+	}
+	entitlements = resolveEntitlements(entitlements, buildSettings)
+
+	capabilities, err := p.TargetCapabilities(target)
+	if err != nil {
+		return ProvisioningSpec{}, err
+	}
+
+	return ProvisioningSpec{
+		BundleID:         bundleID,
+		TeamID:           teamID,
+		Platform:         platformsBySDK[sdk],
+		Entitlements:     entitlements,
+		Capabilities:     capabilities,
+		WildcardEligible: wildcardEligible(entitlements, capabilities),
+	}, nil
+}
+
+func wildcardEligible(entitlements serialized.Object, capabilities map[string]bool) bool {
+	for key := range entitlements {
+		if !wildcardCompatibleEntitlements[key] {
+			return false
+		}
+	}
+
+	for capability, enabled := range capabilities {
+		if enabled && capability != appSandboxCapability {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,107 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_TargetProvisioningSpec(t *testing.T) {
+	tests := []struct {
+		name          string
+		pbxproj       string
+		target        string
+		buildSettings serialized.Object
+		want          ProvisioningSpec
+	}{
+		{
+			name:    "iOS app with push notifications",
+			pbxproj: pbxprojWithBuildFiles,
+			target:  "App",
+			buildSettings: serialized.Object{
+				"CODE_SIGN_ENTITLEMENTS":    "App/App.entitlements",
+				"DEVELOPMENT_TEAM":          "72SA8V3WYL",
+				"PRODUCT_BUNDLE_IDENTIFIER": "io.bitrise.$(PRODUCT_NAME)",
+				"PRODUCT_NAME":              "App",
+				"SDKROOT":                   "iphoneos",
+			},
+			want: ProvisioningSpec{
+				BundleID: "io.bitrise.App",
+				TeamID:   "72SA8V3WYL",
+				Platform: "iOS",
+				Entitlements: serialized.Object{
+					"aps-environment":        "development",
+					"keychain-access-groups": []interface{}{"$(AppIdentifierPrefix)io.bitrise.App"},
+				},
+				Capabilities:     map[string]bool{},
+				WildcardEligible: false,
+			},
+		},
+		{
+			name:    "iOS framework without entitlements",
+			pbxproj: pbxprojWithBuildFiles,
+			target:  "Kit",
+			buildSettings: serialized.Object{
+				"DEVELOPMENT_TEAM":          "72SA8V3WYL",
+				"PRODUCT_BUNDLE_IDENTIFIER": "io.bitrise.Kit",
+				"SDKROOT":                   "iphoneos",
+			},
+			want: ProvisioningSpec{
+				BundleID:         "io.bitrise.Kit",
+				TeamID:           "72SA8V3WYL",
+				Platform:         "iOS",
+				Entitlements:     serialized.Object{},
+				Capabilities:     map[string]bool{},
+				WildcardEligible: true,
+			},
+		},
+		{
+			name:    "sandboxed macOS app",
+			pbxproj: pbxprojMacOS,
+			target:  "App",
+			buildSettings: serialized.Object{
+				"PRODUCT_BUNDLE_IDENTIFIER": "io.bitrise.App",
+				"SDKROOT":                   "macosx",
+				"ENABLE_APP_SANDBOX":        "YES",
+			},
+			want: ProvisioningSpec{
+				BundleID:         "io.bitrise.App",
+				Platform:         "macOS",
+				Entitlements:     serialized.Object{"com.apple.security.app-sandbox": true},
+				Capabilities:     map[string]bool{"com.apple.Sandbox": true, "com.apple.iCloud": false},
+				WildcardEligible: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectPth := createTmpProject(t, "App.xcodeproj", tt.pbxproj, map[string]string{
+				"../App/App.entitlements": pushEntitlements,
+			})
+			project, err := Open(projectPth)
+			require.NoError(t, err)
+			project.SetBuildSettingsProvider(func(target, configuration string) (serialized.Object, error) {
+				return tt.buildSettings, nil
+			})
+
+			got, err := project.TargetProvisioningSpec(tt.target, "Release")
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+const pushEntitlements = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>aps-environment</key>
+	<string>development</string>
+	<key>keychain-access-groups</key>
+	<array>
+		<string>$(AppIdentifierPrefix)$(PRODUCT_BUNDLE_IDENTIFIER)</string>
+	</array>
+</dict>
+</plist>
+`
@@ -0,0 +1,123 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/bitrise-io/go-utils/pathutil"
+	"github.com/bitrise-io/xcode-project/xcscheme"
+)
+
+// SetSchemeBuildConfiguration sets the build configuration of the given action (build, test, launch, archive, analyze or profile)
+// in the project's scheme and saves the scheme file.
+// An error is returned if the scheme or the configuration is not found, or the action is unknown.
+func (p XcodeProj) SetSchemeBuildConfiguration(schemeName, action, configuration string) error {
+	if !p.hasConfiguration(configuration) {
+		return fmt.Errorf("configuration (%s) not found in project (%s)", configuration, p.Name)
+	}
+
+	scheme, _, err := p.Scheme(schemeName)
+	if err != nil {
+		return err
+	}
+
+	return scheme.SetBuildConfiguration(action, configuration)
+}
+
+// RemoveSchemePreActions removes the pre-actions (like scripts) of the given action (build, test, launch or archive)
+// in the project's scheme and saves the scheme file.
+func (p XcodeProj) RemoveSchemePreActions(schemeName, action string) error {
+	scheme, _, err := p.Scheme(schemeName)
+	if err != nil {
+		return err
+	}
+
+	return scheme.RemovePreActions(action)
+}
+
+// RemoveSchemePostActions removes the post-actions of the given action (build, test, launch or archive)
+// in the project's scheme and saves the scheme file.
+func (p XcodeProj) RemoveSchemePostActions(schemeName, action string) error {
+	scheme, _, err := p.Scheme(schemeName)
+	if err != nil {
+		return err
+	}
+
+	return scheme.RemovePostActions(action)
+}
+
+func (p XcodeProj) hasConfiguration(name string) bool {
+	for _, configuration := range p.Proj.BuildConfigurationList.BuildConfigurations {
+		if configuration.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// RecreateSharedSchemes writes a shared scheme for each app target of the project, like the ones Xcode autocreates
+// when the project has no schemes. The scheme is named after the target and tests the test targets hosted by the app.
+// Existing shared schemes are not overwritten; the names of the created schemes are returned.
+func (p XcodeProj) RecreateSharedSchemes() ([]string, error) {
+	if err := p.checkOnDisk(); err != nil {
+		return nil, err
+	}
+
+	debugConfiguration, releaseConfiguration := p.defaultSchemeConfigurations()
+	container := "container:" + filepath.Base(p.Path)
+
+	var created []string
+	for _, target := range p.Proj.Targets {
+		if !target.IsAppProduct() {
+			continue
+		}
+
+		pth := filepath.Join(p.Path, "xcshareddata", "xcschemes", target.Name+".xcscheme")
+		if exist, err := pathutil.IsPathExists(pth); err != nil {
+			return nil, err
+		} else if exist {
+			continue
+		}
+
+		var testables []xcscheme.BuildableReference
+		for _, testTarget := range p.Proj.Targets {
+			if !testTarget.IsTestProduct() && !testTarget.IsUITestProduct() {
+				continue
+			}
+			if host, err := p.TestHostTarget(testTarget.Name); err != nil || host.ID != target.ID {
+				continue
+			}
+			testables = append(testables, schemeBuildableReference(testTarget, container))
+		}
+
+		if err := xcscheme.WriteDefaultScheme(pth, schemeBuildableReference(target, container), testables, debugConfiguration, releaseConfiguration); err != nil {
+			return nil, fmt.Errorf("failed to write scheme (%s): %s", target.Name, err)
+		}
+		created = append(created, target.Name)
+	}
+
+	return created, nil
+}
+
+// defaultSchemeConfigurations returns the Debug and Release configurations if the project has them,
+// falling back to the project's default configuration.
+func (p XcodeProj) defaultSchemeConfigurations() (string, string) {
+	debugConfiguration, releaseConfiguration := "Debug", "Release"
+	defaultConfiguration := p.Proj.BuildConfigurationList.DefaultConfigurationName
+	if !p.hasConfiguration(debugConfiguration) && defaultConfiguration != "" {
+		debugConfiguration = defaultConfiguration
+	}
+	if !p.hasConfiguration(releaseConfiguration) && defaultConfiguration != "" {
+		releaseConfiguration = defaultConfiguration
+	}
+	return debugConfiguration, releaseConfiguration
+}
+
+func schemeBuildableReference(target Target, container string) xcscheme.BuildableReference {
+	return xcscheme.BuildableReference{
+		BlueprintIdentifier: target.ID,
+		BlueprintName:       target.Name,
+		BuildableName:       target.ProductReference.Path,
+		ReferencedContainer: container,
+	}
+}
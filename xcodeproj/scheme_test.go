@@ -0,0 +1,226 @@
+package xcodeproj
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+	"github.com/bitrise-io/go-utils/pathutil"
+	"github.com/bitrise-io/xcode-project/xcscheme"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_SetSchemeBuildConfiguration(t *testing.T) {
+	projectPth := createTmpProject(t, "Target.xcodeproj", pbxprojWithouthTargetAttributes, map[string]string{
+		"xcshareddata/xcschemes/Target.xcscheme": targetSchemeContent,
+	})
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+
+	require.NoError(t, project.SetSchemeBuildConfiguration("Target", xcscheme.ArchiveActionName, "Debug"))
+
+	scheme, _, err := project.Scheme("Target")
+	require.NoError(t, err)
+	require.Equal(t, "Debug", scheme.ArchiveAction.BuildConfiguration)
+
+	require.Error(t, project.SetSchemeBuildConfiguration("Target", xcscheme.ArchiveActionName, "Staging"))
+	require.Error(t, project.SetSchemeBuildConfiguration("Target", "deploy", "Debug"))
+	require.Error(t, project.SetSchemeBuildConfiguration("Missing", xcscheme.ArchiveActionName, "Debug"))
+}
+
+func TestXcodeProj_RemoveSchemePreActions(t *testing.T) {
+	projectPth := createTmpProject(t, "Target.xcodeproj", pbxprojWithouthTargetAttributes, map[string]string{
+		"xcshareddata/xcschemes/Target.xcscheme": targetSchemeWithScriptsContent,
+	})
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+
+	require.NoError(t, project.RemoveSchemePreActions("Target", xcscheme.BuildActionName))
+
+	scheme, _, err := project.Scheme("Target")
+	require.NoError(t, err)
+	preActions, err := scheme.PreActions(xcscheme.BuildActionName)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(preActions))
+	postActions, err := scheme.PostActions(xcscheme.ArchiveActionName)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(postActions))
+
+	require.NoError(t, project.RemoveSchemePostActions("Target", xcscheme.ArchiveActionName))
+
+	content, err := fileutil.ReadStringFromFile(filepath.Join(projectPth, "xcshareddata", "xcschemes", "Target.xcscheme"))
+	require.NoError(t, err)
+	require.Equal(t, targetSchemeContent, content)
+
+	require.Error(t, project.RemoveSchemePreActions("Missing", xcscheme.BuildActionName))
+}
+
+func TestXcodeProj_HasSharedSchemes(t *testing.T) {
+	projectPth := createTmpProject(t, "Target.xcodeproj", pbxprojWithouthTargetAttributes, map[string]string{
+		"xcuserdata/user.xcuserdatad/xcschemes/Target.xcscheme": targetSchemeContent,
+	})
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+
+	hasSharedSchemes, err := project.HasSharedSchemes()
+	require.NoError(t, err)
+	require.False(t, hasSharedSchemes)
+
+	projectPth = createTmpProject(t, "Target.xcodeproj", pbxprojWithouthTargetAttributes, map[string]string{
+		"xcshareddata/xcschemes/Target.xcscheme": targetSchemeContent,
+	})
+	project, err = Open(projectPth)
+	require.NoError(t, err)
+
+	hasSharedSchemes, err = project.HasSharedSchemes()
+	require.NoError(t, err)
+	require.True(t, hasSharedSchemes)
+}
+
+func TestXcodeProj_RecreateSharedSchemes(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithTestTargets, nil)
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+
+	hasSharedSchemes, err := project.HasSharedSchemes()
+	require.NoError(t, err)
+	require.False(t, hasSharedSchemes)
+
+	created, err := project.RecreateSharedSchemes()
+	require.NoError(t, err)
+	require.Equal(t, []string{"App"}, created)
+
+	scheme, _, err := project.Scheme("App")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(projectPth, "xcshareddata", "xcschemes", "App.xcscheme"), scheme.Path)
+	require.Equal(t, "Release", scheme.ArchiveAction.BuildConfiguration)
+	require.Equal(t, "Debug", scheme.TestAction.BuildConfiguration)
+
+	entry, ok := scheme.AppBuildActionEntry()
+	require.True(t, ok)
+	require.Equal(t, "E2B0F0402C8B4A0000A1B2C3", entry.BuildableReference.BlueprintIdentifier)
+	require.Equal(t, "container:App.xcodeproj", entry.BuildableReference.ReferencedContainer)
+
+	var testables []string
+	for _, testable := range scheme.TestAction.Testables {
+		testables = append(testables, testable.BuildableReference.BlueprintName)
+	}
+	require.Equal(t, []string{"AppTests", "AppUITests"}, testables)
+
+	runnable, ok := scheme.RunnableBuildable()
+	require.True(t, ok)
+	require.Equal(t, "App.app", runnable.BuildableName)
+
+	created, err = project.RecreateSharedSchemes()
+	require.NoError(t, err)
+	require.Empty(t, created)
+}
+
+// createTmpProject writes the pbxproj content and the additional files (relative to the project) into a temporary project.
+func createTmpProject(t *testing.T, name, pbxproj string, files map[string]string) string {
+	tmpDir, err := pathutil.NormalizedOSTempDirPath("__xcode-proj__")
+	require.NoError(t, err)
+
+	projectPth := filepath.Join(tmpDir, name)
+	require.NoError(t, os.MkdirAll(projectPth, 0755))
+	require.NoError(t, fileutil.WriteStringToFile(filepath.Join(projectPth, "project.pbxproj"), pbxproj))
+
+	for pth, content := range files {
+		filePth := filepath.Join(projectPth, pth)
+		require.NoError(t, os.MkdirAll(filepath.Dir(filePth), 0755))
+		require.NoError(t, fileutil.WriteStringToFile(filePth, content))
+	}
+
+	return projectPth
+}
+
+const targetSchemeContent = `<?xml version="1.0" encoding="UTF-8"?>
+<Scheme
+   LastUpgradeVersion = "1220"
+   version = "1.3">
+   <BuildAction
+      parallelizeBuildables = "YES"
+      buildImplicitDependencies = "YES">
+      <BuildActionEntries>
+         <BuildActionEntry
+            buildForTesting = "YES"
+            buildForRunning = "YES"
+            buildForProfiling = "YES"
+            buildForArchiving = "YES"
+            buildForAnalyzing = "YES">
+            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "13BD62FD256BE6D000F72361"
+               BuildableName = "Target.app"
+               BlueprintName = "Target"
+               ReferencedContainer = "container:Target.xcodeproj">
+            </BuildableReference>
+         </BuildActionEntry>
+      </BuildActionEntries>
+   </BuildAction>
+   <TestAction
+      buildConfiguration = "Debug"
+      selectedDebuggerIdentifier = "Xcode.DebuggerFoundation.Debugger.LLDB"
+      selectedLauncherIdentifier = "Xcode.DebuggerFoundation.Launcher.LLDB"
+      shouldUseLaunchSchemeArgsEnv = "YES">
+      <Testables>
+      </Testables>
+   </TestAction>
+   <LaunchAction
+      buildConfiguration = "Debug"
+      selectedDebuggerIdentifier = "Xcode.DebuggerFoundation.Debugger.LLDB"
+      selectedLauncherIdentifier = "Xcode.DebuggerFoundation.Launcher.LLDB"
+      launchStyle = "0"
+      useCustomWorkingDirectory = "NO"
+      ignoresPersistentStateOnLaunch = "NO"
+      debugDocumentVersioning = "YES"
+      debugServiceExtension = "internal"
+      allowLocationSimulation = "YES">
+      <BuildableProductRunnable
+         runnableDebuggingMode = "0">
+         <BuildableReference
+            BuildableIdentifier = "primary"
+            BlueprintIdentifier = "13BD62FD256BE6D000F72361"
+            BuildableName = "Target.app"
+            BlueprintName = "Target"
+            ReferencedContainer = "container:Target.xcodeproj">
+         </BuildableReference>
+      </BuildableProductRunnable>
+   </LaunchAction>
+   <ArchiveAction
+      buildConfiguration = "Release"
+      revealArchiveInOrganizer = "YES">
+   </ArchiveAction>
+</Scheme>
+`
+
+// targetSchemeWithScriptsContent runs a script from the developer's machine before the build and after the archive.
+var targetSchemeWithScriptsContent = newFixtureReplacer(
+	`      buildImplicitDependencies = "YES">
+      <BuildActionEntries>`,
+	`      buildImplicitDependencies = "YES">
+      <PreActions>
+         <ExecutionAction
+            ActionType = "Xcode.IDEStandardExecutionActionsCore.ExecutionActionType.ShellScriptAction">
+            <ActionContent
+               title = "Run Script"
+               scriptText = "/Users/developer/bin/setup-env.sh&#10;">
+            </ActionContent>
+         </ExecutionAction>
+      </PreActions>
+      <BuildActionEntries>`,
+	`      revealArchiveInOrganizer = "YES">
+   </ArchiveAction>`,
+	`      revealArchiveInOrganizer = "YES">
+      <PostActions>
+         <ExecutionAction
+            ActionType = "Xcode.IDEStandardExecutionActionsCore.ExecutionActionType.ShellScriptAction">
+            <ActionContent
+               title = "Run Script"
+               scriptText = "open ~/Library/Developer/Xcode/Archives&#10;">
+            </ActionContent>
+         </ExecutionAction>
+      </PostActions>
+   </ArchiveAction>`,
+).Replace(targetSchemeContent)
@@ -0,0 +1,126 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+const shellScriptBuildPhaseType = "PBXShellScriptBuildPhase"
+
+// TargetUserScriptSandboxing reports whether the target's run script build phases are sandboxed
+// (ENABLE_USER_SCRIPT_SANDBOXING = YES): the scripts can only read their declared input files and write their declared output files.
+func (p XcodeProj) TargetUserScriptSandboxing(target, configuration string) (bool, error) {
+	enabled, _, err := p.TargetBoolSetting(target, configuration, "ENABLE_USER_SCRIPT_SANDBOXING")
+	return enabled, err
+}
+
+// SetTargetUserScriptSandboxing sets the target's ENABLE_USER_SCRIPT_SANDBOXING build setting in the given configuration,
+// or in all of the target's configurations if the configuration is empty.
+// The project needs to be saved to persist the change.
+func (p XcodeProj) SetTargetUserScriptSandboxing(target, configuration string, enabled bool) error {
+	return p.setTargetBuildSetting(target, configuration, "ENABLE_USER_SCRIPT_SANDBOXING", boolBuildSettingValue(enabled))
+}
+
+// AuditScriptSandboxing checks the target's configurations sandboxing the user scripts (see TargetUserScriptSandboxing),
+// and returns a warning for each run script build phase not declaring its input or output files
+// (neither input/output paths nor input/output file lists), since these scripts commonly fail in the sandbox.
+func (p XcodeProj) AuditScriptSandboxing(targetName string) ([]Warning, error) {
+	target, ok := p.Proj.TargetByName(targetName)
+	if !ok {
+		return nil, fmt.Errorf("target not found: %s", targetName)
+	}
+
+	objects, err := p.RawProj.Object("objects")
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []string
+	for _, phaseID := range target.buildPhaseIDs {
+		phase, err := objects.Object(phaseID)
+		if err != nil {
+			return nil, err
+		}
+
+		if isa, err := phase.String("isa"); err != nil {
+			return nil, err
+		} else if isa != shellScriptBuildPhaseType {
+			continue
+		}
+
+		message, err := undeclaredScriptFilesMessage(phase)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check run script build phase (%s): %s", phaseID, err)
+		}
+		if message != "" {
+			messages = append(messages, message)
+		}
+	}
+
+	if len(messages) == 0 {
+		return nil, nil
+	}
+
+	var warnings []Warning
+	for _, buildConfiguration := range target.BuildConfigurationList.BuildConfigurations {
+		enabled, err := p.TargetUserScriptSandboxing(target.Name, buildConfiguration.Name)
+		if err != nil {
+			return nil, err
+		}
+		if !enabled {
+			continue
+		}
+
+		for _, message := range messages {
+			warnings = append(warnings, Warning{
+				Target:        target.Name,
+				Configuration: buildConfiguration.Name,
+				BuildSetting:  "ENABLE_USER_SCRIPT_SANDBOXING",
+				Value:         boolBuildSettingValue(enabled),
+				Message:       message,
+			})
+		}
+	}
+	return warnings, nil
+}
+
+// undeclaredScriptFilesMessage describes the files the run script build phase does not declare,
+// or returns an empty string if it declares both its input and output files.
+func undeclaredScriptFilesMessage(phase serialized.Object) (string, error) {
+	name, err := optionalString(phase, "name")
+	if err != nil {
+		return "", err
+	} else if name == "" {
+		name = "Run Script"
+	}
+
+	var undeclared []string
+	for _, files := range []struct {
+		kind string
+		keys []string
+	}{
+		{kind: "input", keys: []string{"inputPaths", "inputFileListPaths"}},
+		{kind: "output", keys: []string{"outputPaths", "outputFileListPaths"}},
+	} {
+		declared := false
+		for _, key := range files.keys {
+			paths, err := phase.StringSlice(key)
+			if err != nil && !serialized.IsKeyNotFoundError(err) {
+				return "", err
+			}
+			if len(paths) > 0 {
+				declared = true
+			}
+		}
+		if !declared {
+			undeclared = append(undeclared, files.kind)
+		}
+	}
+
+	if len(undeclared) == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("run script phase (%s) declares no %s files, it may fail in the sandbox", name, strings.Join(undeclared, " or ")), nil
+}
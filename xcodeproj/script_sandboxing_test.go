@@ -0,0 +1,117 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_AuditScriptSandboxing(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithSandboxedScripts))
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(*project))
+
+	enabled, err := project.TargetUserScriptSandboxing("App", "Debug")
+	require.NoError(t, err)
+	require.True(t, enabled)
+
+	enabled, err = project.TargetUserScriptSandboxing("App", "Release")
+	require.NoError(t, err)
+	require.False(t, enabled)
+
+	warnings, err := project.AuditScriptSandboxing("App")
+	require.NoError(t, err)
+	require.Equal(t, []Warning{
+		{
+			Target:        "App",
+			Configuration: "Debug",
+			BuildSetting:  "ENABLE_USER_SCRIPT_SANDBOXING",
+			Value:         "YES",
+			Message:       "run script phase (SwiftLint) declares no input or output files, it may fail in the sandbox",
+		},
+		{
+			Target:        "App",
+			Configuration: "Debug",
+			BuildSetting:  "ENABLE_USER_SCRIPT_SANDBOXING",
+			Value:         "YES",
+			Message:       "run script phase (Run Script) declares no output files, it may fail in the sandbox",
+		},
+	}, warnings)
+
+	require.NoError(t, project.SetTargetUserScriptSandboxing("App", "", false))
+	warnings, err = project.AuditScriptSandboxing("App")
+	require.NoError(t, err)
+	require.Empty(t, warnings)
+
+	_, err = project.AuditScriptSandboxing("Missing")
+	require.EqualError(t, err, "target not found: Missing")
+}
+
+// pbxprojWithSandboxedScripts sandboxes the App target's scripts in Debug. The App target runs SwiftLint without declared files,
+// a script declaring its input and output paths and an unnamed script declaring its input file list only.
+var pbxprojWithSandboxedScripts = newFixtureReplacer(
+	`				E2B0F0242C8B4A0000A1B2C3 /* Embed Frameworks */,
+`, `				E2B0F0242C8B4A0000A1B2C3 /* Embed Frameworks */,
+				E2B0F0C02C8B4A0000A1B2C3 /* SwiftLint */,
+				E2B0F0C12C8B4A0000A1B2C3 /* Copy Config */,
+				E2B0F0C22C8B4A0000A1B2C3 /* ShellScript */,
+`,
+	`/* Begin PBXSourcesBuildPhase section */`, `/* Begin PBXShellScriptBuildPhase section */
+		E2B0F0C02C8B4A0000A1B2C3 /* SwiftLint */ = {
+			isa = PBXShellScriptBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+			);
+			inputFileListPaths = (
+			);
+			inputPaths = (
+			);
+			name = SwiftLint;
+			outputFileListPaths = (
+			);
+			outputPaths = (
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+			shellPath = /bin/sh;
+			shellScript = "swiftlint\n";
+		};
+		E2B0F0C12C8B4A0000A1B2C3 /* Copy Config */ = {
+			isa = PBXShellScriptBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+			);
+			inputPaths = (
+				"$(SRCROOT)/Config.plist",
+			);
+			name = "Copy Config";
+			outputPaths = (
+				"$(TARGET_BUILD_DIR)/$(UNLOCALIZED_RESOURCES_FOLDER_PATH)/Config.plist",
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+			shellPath = /bin/sh;
+			shellScript = "cp \"$SCRIPT_INPUT_FILE_0\" \"$SCRIPT_OUTPUT_FILE_0\"\n";
+		};
+		E2B0F0C22C8B4A0000A1B2C3 /* ShellScript */ = {
+			isa = PBXShellScriptBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+			);
+			inputFileListPaths = (
+				"$(SRCROOT)/Scripts/inputs.xcfilelist",
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+			shellPath = /bin/sh;
+			shellScript = "./Scripts/generate.sh\n";
+		};
+/* End PBXShellScriptBuildPhase section */
+
+/* Begin PBXSourcesBuildPhase section */`,
+	`				PRODUCT_NAME = "$(TARGET_NAME)";
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Debug;`, `				ENABLE_USER_SCRIPT_SANDBOXING = YES;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Debug;`,
+).Replace(pbxprojWithBuildFiles)
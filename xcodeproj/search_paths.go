@@ -0,0 +1,220 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/bitrise-io/go-utils/pathutil"
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// recursiveSearchPathSuffix marks the search paths Xcode searches recursively.
+const recursiveSearchPathSuffix = "/**"
+
+// inheritedBuildSetting is the reference to the build setting's value from the enclosing level.
+const inheritedBuildSetting = "$(inherited)"
+
+// TargetHeaderSearchPaths returns the target's resolved HEADER_SEARCH_PATHS.
+func (p XcodeProj) TargetHeaderSearchPaths(target, configuration string) ([]string, error) {
+	return p.targetSearchPaths(target, configuration, "HEADER_SEARCH_PATHS")
+}
+
+// TargetFrameworkSearchPaths returns the target's resolved FRAMEWORK_SEARCH_PATHS.
+func (p XcodeProj) TargetFrameworkSearchPaths(target, configuration string) ([]string, error) {
+	return p.targetSearchPaths(target, configuration, "FRAMEWORK_SEARCH_PATHS")
+}
+
+// TargetLibrarySearchPaths returns the target's resolved LIBRARY_SEARCH_PATHS.
+func (p XcodeProj) TargetLibrarySearchPaths(target, configuration string) ([]string, error) {
+	return p.targetSearchPaths(target, configuration, "LIBRARY_SEARCH_PATHS")
+}
+
+// TargetOtherCFlags returns the target's OTHER_CFLAGS, with the build setting references resolved.
+func (p XcodeProj) TargetOtherCFlags(target, configuration string) ([]string, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	flags, err := buildSettingList(buildSettings, "OTHER_CFLAGS")
+	if err != nil {
+		return nil, err
+	}
+
+	var resolved []string
+	for _, flag := range flags {
+		if isInheritedReference(flag) {
+			continue
+		}
+		resolved = append(resolved, resolveKnownReferences(flag, buildSettings))
+	}
+	return resolved, nil
+}
+
+// targetSearchPaths returns the target's resolved search paths, which are on disk,
+// so projects opened by OpenFS return an error.
+func (p XcodeProj) targetSearchPaths(target, configuration, key string) ([]string, error) {
+	if err := p.checkOnDisk(); err != nil {
+		return nil, err
+	}
+
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return searchPaths(buildSettings, key, filepath.Dir(p.Path))
+}
+
+// searchPaths returns the paths of the search path build setting, with the build setting references resolved
+// and the relative paths made absolute against the SRCROOT (the projectDir if SRCROOT is not set).
+// The $(inherited) entries are dropped, as there is no enclosing level to inherit from.
+func searchPaths(buildSettings serialized.Object, key, projectDir string) ([]string, error) {
+	entries, err := buildSettingList(buildSettings, key)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceRoot := projectDir
+	if srcRoot, err := buildSettings.String("SRCROOT"); err == nil && srcRoot != "" {
+		sourceRoot = srcRoot
+	} else {
+		buildSettings = withBuildSetting(buildSettings, "SRCROOT", sourceRoot)
+	}
+
+	var paths []string
+	for _, entry := range entries {
+		if isInheritedReference(entry) {
+			continue
+		}
+
+		pth := resolveKnownReferences(entry, buildSettings)
+		if pathutil.IsRelativePath(pth) {
+			pth = filepath.Join(sourceRoot, pth)
+		}
+		paths = append(paths, pth)
+	}
+
+	return paths, nil
+}
+
+// IsRecursiveSearchPath reports whether the search path is searched recursively (ends with /**).
+func IsRecursiveSearchPath(pth string) bool {
+	return strings.HasSuffix(pth, recursiveSearchPathSuffix)
+}
+
+// ExpandSearchPath returns the directories searched for the search path.
+// A recursive search path is expanded to its root and every directory below it, ordered as walked,
+// other search paths are returned as is. Missing recursive search path roots are expanded to no directories,
+// as Xcode ignores them.
+func ExpandSearchPath(pth string) ([]string, error) {
+	if !IsRecursiveSearchPath(pth) {
+		return []string{pth}, nil
+	}
+
+	root := strings.TrimSuffix(pth, recursiveSearchPathSuffix)
+	if exist, err := pathutil.IsDirExists(root); err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, nil
+	}
+
+	var dirs []string
+	if err := filepath.Walk(root, func(walkPth string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			dirs = append(dirs, walkPth)
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to expand search path (%s): %s", pth, err)
+	}
+
+	return dirs, nil
+}
+
+// withBuildSetting returns a copy of the build settings with the key set to the value.
+func withBuildSetting(buildSettings serialized.Object, key, value string) serialized.Object {
+	copied := serialized.Object{}
+	for k, v := range buildSettings {
+		copied[k] = v
+	}
+	copied[key] = value
+	return copied
+}
+
+// buildSettingList returns the entries of a list type build setting, which is either stored as
+// a whitespace separated string or as an array of strings.
+func buildSettingList(buildSettings serialized.Object, key string) ([]string, error) {
+	value, err := buildSettings.Value(key)
+	if err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	switch v := value.(type) {
+	case string:
+		return splitBuildSettingList(v), nil
+	case []interface{}:
+		var entries []string
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, serialized.NewTypeCastError(key, value, "")
+			}
+			entries = append(entries, splitBuildSettingList(s)...)
+		}
+		return entries, nil
+	default:
+		return nil, fmt.Errorf("unsupported %s build setting value: %v", key, value)
+	}
+}
+
+// splitBuildSettingList splits the list the way Xcode does: on whitespace, except within quotes or after a backslash.
+// The quotes and escaping backslashes are removed.
+// **Example:** `"$(SRCROOT)/Vendor Libs"/** $(inherited)` **=>** `$(SRCROOT)/Vendor Libs/**`, `$(inherited)`
+func splitBuildSettingList(value string) []string {
+	var entries []string
+	var entry strings.Builder
+	var quote rune
+	inEntry, escaped := false, false
+
+	for _, r := range value {
+		switch {
+		case escaped:
+			entry.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped, inEntry = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				entry.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote, inEntry = r, true
+		case unicode.IsSpace(r):
+			if inEntry {
+				entries = append(entries, entry.String())
+				entry.Reset()
+				inEntry = false
+			}
+		default:
+			entry.WriteRune(r)
+			inEntry = true
+		}
+	}
+
+	if inEntry {
+		entries = append(entries, entry.String())
+	}
+	return entries
+}
@@ -0,0 +1,109 @@
+package xcodeproj
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+	"github.com/bitrise-io/go-utils/pathutil"
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_searchPaths(t *testing.T) {
+	tests := []struct {
+		name          string
+		buildSettings serialized.Object
+		want          []string
+	}{
+		{
+			name: "xcodebuild output",
+			buildSettings: serialized.Object{
+				"SRCROOT":             "/project",
+				"HEADER_SEARCH_PATHS": `/project/Vendor/include "/project/Vendor Libs/include"/** /usr/include`,
+			},
+			want: []string{"/project/Vendor/include", "/project/Vendor Libs/include/**", "/usr/include"},
+		},
+		{
+			name: "raw project setting",
+			buildSettings: serialized.Object{
+				"HEADER_SEARCH_PATHS": []interface{}{
+					"$(inherited)",
+					`"$(SRCROOT)/Pods/Headers Public"/**`,
+					"Vendor/include",
+					`$(PROJECT_DIR)/Escaped\ Path`,
+				},
+				"PROJECT_DIR": "/project",
+			},
+			want: []string{"/project/Pods/Headers Public/**", "/project/Vendor/include", "/project/Escaped Path"},
+		},
+		{
+			name: "not set",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := searchPaths(tt.buildSettings, "HEADER_SEARCH_PATHS", "/project")
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_splitBuildSettingList(t *testing.T) {
+	require.Equal(t, []string{"-DDEBUG=1", "-framework", "UIKit"}, splitBuildSettingList("  -DDEBUG=1\t-framework UIKit "))
+	require.Equal(t, []string{"a b", "c'd", ""}, splitBuildSettingList(`'a b' "c'd" ""`))
+	require.Equal(t, []string(nil), splitBuildSettingList(""))
+}
+
+func TestXcodeProj_TargetOtherCFlags(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithBuildFiles))
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(func(target, configuration string) (serialized.Object, error) {
+		return serialized.Object{
+			"OTHER_CFLAGS": `$(inherited) -DKIT_NAME=\"$(PRODUCT_NAME)\" -fmodules`,
+			"PRODUCT_NAME": "Kit",
+		}, nil
+	})
+
+	flags, err := project.TargetOtherCFlags("Kit", "Debug")
+	require.NoError(t, err)
+	require.Equal(t, []string{`-DKIT_NAME="Kit"`, "-fmodules"}, flags)
+}
+
+func TestExpandSearchPath(t *testing.T) {
+	tmpDir, err := pathutil.NormalizedOSTempDirPath("__search_paths__")
+	require.NoError(t, err)
+	root := filepath.Join(tmpDir, "Vendor Libs")
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "include", "Kit"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "lib"), 0755))
+	require.NoError(t, fileutil.WriteStringToFile(filepath.Join(root, "include", "Kit", "Kit.h"), ""))
+
+	paths, err := searchPaths(serialized.Object{
+		"HEADER_SEARCH_PATHS": `"$(SRCROOT)/Vendor Libs"/** $(SRCROOT)/Missing/** /usr/include`,
+	}, "HEADER_SEARCH_PATHS", tmpDir)
+	require.NoError(t, err)
+	require.Equal(t, []string{root + "/**", tmpDir + "/Missing/**", "/usr/include"}, paths)
+
+	require.True(t, IsRecursiveSearchPath(paths[0]))
+	require.True(t, IsRecursiveSearchPath(paths[1]))
+	require.False(t, IsRecursiveSearchPath(paths[2]))
+
+	dirs, err := ExpandSearchPath(paths[0])
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		root,
+		filepath.Join(root, "include"),
+		filepath.Join(root, "include", "Kit"),
+		filepath.Join(root, "lib"),
+	}, dirs)
+
+	dirs, err = ExpandSearchPath(paths[1])
+	require.NoError(t, err)
+	require.Equal(t, []string(nil), dirs)
+
+	dirs, err = ExpandSearchPath(paths[2])
+	require.NoError(t, err)
+	require.Equal(t, []string{"/usr/include"}, dirs)
+}
@@ -0,0 +1,53 @@
+package xcodeproj
+
+import "strings"
+
+// ProjectStats are the size metrics of the project's object graph.
+type ProjectStats struct {
+	Objects        int
+	Targets        int
+	Files          int
+	BuildPhases    int
+	Configurations int
+	// ObjectsByISA are the number of objects by their type, like PBXBuildFile.
+	ObjectsByISA map[string]int
+}
+
+// Stats returns the number of the project's objects, targets (native, aggregate and legacy), file references,
+// build phases and build configurations.
+func (p XcodeProj) Stats() ProjectStats {
+	stats := ProjectStats{ObjectsByISA: map[string]int{}}
+
+	objects, err := p.RawProj.Object("objects")
+	if err != nil {
+		return stats
+	}
+
+	for _, id := range objects.Keys() {
+		object, err := objects.Object(id)
+		if err != nil {
+			continue
+		}
+
+		isa, err := object.String("isa")
+		if err != nil {
+			continue
+		}
+
+		stats.Objects++
+		stats.ObjectsByISA[isa]++
+
+		switch {
+		case isa == "PBXNativeTarget" || isa == "PBXAggregateTarget" || isa == "PBXLegacyTarget":
+			stats.Targets++
+		case isa == fileReferenceElementType:
+			stats.Files++
+		case strings.HasSuffix(isa, "BuildPhase"):
+			stats.BuildPhases++
+		case isa == "XCBuildConfiguration":
+			stats.Configurations++
+		}
+	}
+
+	return stats
+}
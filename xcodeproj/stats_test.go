@@ -0,0 +1,34 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_Stats(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithBuildFiles))
+	require.NoError(t, err)
+
+	require.Equal(t, ProjectStats{
+		Objects:        39,
+		Targets:        2,
+		Files:          8,
+		BuildPhases:    7,
+		Configurations: 6,
+		ObjectsByISA: map[string]int{
+			"PBXBuildFile":            8,
+			"PBXCopyFilesBuildPhase":  1,
+			"PBXFileReference":        8,
+			"PBXFrameworksBuildPhase": 2,
+			"PBXGroup":                4,
+			"PBXHeadersBuildPhase":    1,
+			"PBXNativeTarget":         2,
+			"PBXProject":              1,
+			"PBXResourcesBuildPhase":  1,
+			"PBXSourcesBuildPhase":    2,
+			"XCBuildConfiguration":    6,
+			"XCConfigurationList":     3,
+		},
+	}, project.Stats())
+}
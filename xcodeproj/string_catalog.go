@@ -0,0 +1,74 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+const stringCatalogFileType = "text.json.xcstrings"
+
+// StringCatalogs returns the absolute paths of the string catalogs (Localizable.xcstrings files)
+// copied by the target's resources build phases, in the order of the build phases' files.
+// A file reference is a string catalog if its (last known or explicit) file type is text.json.xcstrings,
+// or if it has no file type and its path has the .xcstrings extension.
+func (p XcodeProj) StringCatalogs(targetName string) ([]string, error) {
+	buildFiles, err := p.BuildFiles(targetName)
+	if err != nil {
+		return nil, err
+	}
+
+	objects, err := p.RawProj.Object("objects")
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for _, buildFile := range buildFiles {
+		if buildFile.PhaseType != resourcesBuildPhaseType || buildFile.FileRef == "" {
+			continue
+		}
+
+		fileRef, err := objects.Object(buildFile.FileRef)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok, err := isStringCatalog(fileRef); err != nil {
+			return nil, err
+		} else if !ok {
+			continue
+		}
+
+		pth, err := p.fileReferenceAbsolutePath(buildFile.FileRef, objects)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve the path of string catalog (%s): %s", buildFile.FileRef, err)
+		}
+		paths = append(paths, pth)
+	}
+
+	return paths, nil
+}
+
+func isStringCatalog(fileRef serialized.Object) (bool, error) {
+	if ok, err := isFileReference(fileRef); err != nil || !ok {
+		return false, err
+	}
+
+	for _, key := range []string{"lastKnownFileType", "explicitFileType"} {
+		fileType, err := optionalString(fileRef, key)
+		if err != nil {
+			return false, err
+		}
+		if fileType != "" {
+			return fileType == stringCatalogFileType, nil
+		}
+	}
+
+	pth, err := optionalString(fileRef, "path")
+	if err != nil {
+		return false, err
+	}
+	return filepath.Ext(pth) == ".xcstrings", nil
+}
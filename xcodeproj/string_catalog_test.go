@@ -0,0 +1,38 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_StringCatalogs(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithStringCatalog))
+	require.NoError(t, err)
+	project.Path = "/Users/bitrise/App/App.xcodeproj"
+
+	catalogs, err := project.StringCatalogs("App")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/Users/bitrise/App/App/Localizable.xcstrings"}, catalogs)
+
+	catalogs, err = project.StringCatalogs("Kit")
+	require.NoError(t, err)
+	require.Empty(t, catalogs)
+
+	_, err = project.StringCatalogs("Missing")
+	require.EqualError(t, err, "target not found: Missing")
+}
+
+// pbxprojWithStringCatalog copies a Localizable.xcstrings string catalog of the App group in the App target's resources build phase.
+var pbxprojWithStringCatalog = newFixtureReplacer(
+	`/* End PBXBuildFile section */`, `		E2B0F0B12C8B4A0000A1B2C3 /* Localizable.xcstrings in Resources */ = {isa = PBXBuildFile; fileRef = E2B0F0B02C8B4A0000A1B2C3 /* Localizable.xcstrings */; };
+/* End PBXBuildFile section */`,
+	`/* End PBXFileReference section */`, `		E2B0F0B02C8B4A0000A1B2C3 /* Localizable.xcstrings */ = {isa = PBXFileReference; lastKnownFileType = text.json.xcstrings; path = Localizable.xcstrings; sourceTree = "<group>"; };
+/* End PBXFileReference section */`,
+	`				E2B0F0082C8B4A0000A1B2C3 /* Assets.xcassets */,
+			);`, `				E2B0F0082C8B4A0000A1B2C3 /* Assets.xcassets */,
+				E2B0F0B02C8B4A0000A1B2C3 /* Localizable.xcstrings */,
+			);`,
+	`				E2B0F0182C8B4A0000A1B2C3 /* Assets.xcassets in Resources */,`, `				E2B0F0182C8B4A0000A1B2C3 /* Assets.xcassets in Resources */,
+				E2B0F0B12C8B4A0000A1B2C3 /* Localizable.xcstrings in Resources */,`,
+).Replace(pbxprojWithBuildFiles)
@@ -0,0 +1,91 @@
+package xcodeproj
+
+import (
+	"fmt"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// Swift strict concurrency checking levels (SWIFT_STRICT_CONCURRENCY build setting values).
+const (
+	SwiftStrictConcurrencyMinimal  = "minimal"
+	SwiftStrictConcurrencyTargeted = "targeted"
+	SwiftStrictConcurrencyComplete = "complete"
+)
+
+// TargetSwiftStrictConcurrency returns the target's Swift concurrency checking level (SWIFT_STRICT_CONCURRENCY):
+// SwiftStrictConcurrencyMinimal, SwiftStrictConcurrencyTargeted or SwiftStrictConcurrencyComplete.
+// If the build setting is not set, the default of the target's Swift language mode is returned:
+// complete for Swift 6 and later, minimal otherwise.
+func (p XcodeProj) TargetSwiftStrictConcurrency(target, configuration string) (string, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return "", err
+	}
+
+	return swiftStrictConcurrency(buildSettings)
+}
+
+// SetTargetSwiftStrictConcurrency sets the target's SWIFT_STRICT_CONCURRENCY build setting to the level
+// in the given configuration, or in all of the target's configurations if the configuration is empty.
+// The project needs to be saved to persist the change.
+func (p XcodeProj) SetTargetSwiftStrictConcurrency(target, configuration, level string) error {
+	if !isSwiftStrictConcurrencyLevel(level) {
+		return fmt.Errorf("unknown Swift strict concurrency level: %s", level)
+	}
+
+	return p.setTargetBuildSetting(target, configuration, "SWIFT_STRICT_CONCURRENCY", level)
+}
+
+// AuditSwiftStrictConcurrency checks the configurations of the project's Swift (SWIFT_VERSION setting) native targets,
+// and returns a warning for each of them not checking concurrency completely (see TargetSwiftStrictConcurrency).
+func (p XcodeProj) AuditSwiftStrictConcurrency() ([]Warning, error) {
+	return p.auditConfigurations(func(string) bool { return true }, func(target, configuration string, buildSettings serialized.Object) ([]Warning, error) {
+		if swiftVersion, _, err := resolvedBuildSetting(buildSettings, "SWIFT_VERSION"); err != nil || swiftVersion == "" {
+			return nil, err
+		}
+
+		level, err := swiftStrictConcurrency(buildSettings)
+		if err != nil || level == SwiftStrictConcurrencyComplete {
+			return nil, err
+		}
+
+		return []Warning{{
+			Target:        target,
+			Configuration: configuration,
+			BuildSetting:  "SWIFT_STRICT_CONCURRENCY",
+			Value:         level,
+			Message:       "Swift concurrency is not checked completely",
+		}}, nil
+	})
+}
+
+func swiftStrictConcurrency(buildSettings serialized.Object) (string, error) {
+	level, found, err := resolvedBuildSetting(buildSettings, "SWIFT_STRICT_CONCURRENCY")
+	if err != nil {
+		return "", err
+	} else if found && level != "" {
+		if !isSwiftStrictConcurrencyLevel(level) {
+			return "", fmt.Errorf("unknown Swift strict concurrency level: %s", level)
+		}
+		return level, nil
+	}
+
+	swiftVersion, _, err := resolvedBuildSetting(buildSettings, "SWIFT_VERSION")
+	if err != nil {
+		return "", err
+	}
+	if swiftVersion != "" && compareVersions(swiftVersion, "6") >= 0 {
+		return SwiftStrictConcurrencyComplete, nil
+	}
+	return SwiftStrictConcurrencyMinimal, nil
+}
+
+func isSwiftStrictConcurrencyLevel(level string) bool {
+	switch level {
+	case SwiftStrictConcurrencyMinimal, SwiftStrictConcurrencyTargeted, SwiftStrictConcurrencyComplete:
+		return true
+	default:
+		return false
+	}
+}
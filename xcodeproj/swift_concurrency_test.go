@@ -0,0 +1,97 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_swiftStrictConcurrency(t *testing.T) {
+	tests := []struct {
+		name          string
+		buildSettings serialized.Object
+		want          string
+		wantErr       bool
+	}{
+		{name: "not set", buildSettings: serialized.Object{}, want: "minimal"},
+		{name: "Swift 5 default", buildSettings: serialized.Object{"SWIFT_VERSION": "5.0"}, want: "minimal"},
+		{name: "Swift 6 default", buildSettings: serialized.Object{"SWIFT_VERSION": "6.0"}, want: "complete"},
+		{name: "set", buildSettings: serialized.Object{"SWIFT_VERSION": "5.0", "SWIFT_STRICT_CONCURRENCY": "targeted"}, want: "targeted"},
+		{name: "Swift 6 set", buildSettings: serialized.Object{"SWIFT_VERSION": "6.0", "SWIFT_STRICT_CONCURRENCY": "minimal"}, want: "minimal"},
+		{name: "unknown", buildSettings: serialized.Object{"SWIFT_STRICT_CONCURRENCY": "strict"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := swiftStrictConcurrency(tt.buildSettings)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestXcodeProj_AuditSwiftStrictConcurrency(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithSwiftStrictConcurrency))
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(*project))
+
+	level, err := project.TargetSwiftStrictConcurrency("App", "Debug")
+	require.NoError(t, err)
+	require.Equal(t, SwiftStrictConcurrencyTargeted, level)
+
+	level, err = project.TargetSwiftStrictConcurrency("App", "Release")
+	require.NoError(t, err)
+	require.Equal(t, SwiftStrictConcurrencyMinimal, level)
+
+	warnings, err := project.AuditSwiftStrictConcurrency()
+	require.NoError(t, err)
+	require.Equal(t, []Warning{
+		{
+			Target:        "App",
+			Configuration: "Debug",
+			BuildSetting:  "SWIFT_STRICT_CONCURRENCY",
+			Value:         "targeted",
+			Message:       "Swift concurrency is not checked completely",
+		},
+		{
+			Target:        "App",
+			Configuration: "Release",
+			BuildSetting:  "SWIFT_STRICT_CONCURRENCY",
+			Value:         "minimal",
+			Message:       "Swift concurrency is not checked completely",
+		},
+	}, warnings)
+
+	require.Error(t, project.SetTargetSwiftStrictConcurrency("App", "", "strict"))
+	require.NoError(t, project.SetTargetSwiftStrictConcurrency("App", "", SwiftStrictConcurrencyComplete))
+
+	warnings, err = project.AuditSwiftStrictConcurrency()
+	require.NoError(t, err)
+	require.Empty(t, warnings)
+}
+
+// pbxprojWithSwiftStrictConcurrency builds the App target in Swift 5 mode, checking concurrency in a targeted way in Debug
+// and on the minimal default level in Release. The Kit target has no Swift version.
+var pbxprojWithSwiftStrictConcurrency = newFixtureReplacer(
+	`				PRODUCT_NAME = "$(TARGET_NAME)";
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Debug;`, `				PRODUCT_NAME = "$(TARGET_NAME)";
+				SWIFT_STRICT_CONCURRENCY = targeted;
+				SWIFT_VERSION = 5.0;
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Debug;`,
+	`				PRODUCT_NAME = "$(TARGET_NAME)";
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Release;`, `				PRODUCT_NAME = "$(TARGET_NAME)";
+				SWIFT_VERSION = 5.0;
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Release;`,
+).Replace(pbxprojWithBuildFiles)
@@ -0,0 +1,71 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+var swiftToolsVersionPattern = regexp.MustCompile(`(?m)^//\s*swift-tools-version\s*:\s*([^\s;]+)`)
+
+// LocalPackageToolsVersions returns the swift-tools-version of the project's local Swift packages (XCLocalSwiftPackageReference),
+// mapped by the package's project relative path.
+// The version is read from the package's Package.swift manifest.
+func (p XcodeProj) LocalPackageToolsVersions() (map[string]string, error) {
+	if err := p.checkOnDisk(); err != nil {
+		return nil, err
+	}
+
+	objects, err := p.RawProj.Object("objects")
+	if err != nil {
+		return nil, err
+	}
+
+	toolsVersions := map[string]string{}
+	for id := range objects {
+		object, err := objects.Object(id)
+		if err != nil {
+			return nil, err
+		}
+
+		if isa, err := object.String("isa"); err != nil {
+			return nil, err
+		} else if isa != "XCLocalSwiftPackageReference" {
+			continue
+		}
+
+		relativePath, err := object.String("relativePath")
+		if err != nil {
+			if serialized.IsKeyNotFoundError(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		manifestPth := filepath.Join(filepath.Dir(p.Path), relativePath, "Package.swift")
+		manifest, err := fileutil.ReadStringFromFile(manifestPth)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read local package manifest: %s", err)
+		}
+
+		toolsVersion, err := swiftToolsVersion(manifest)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", manifestPth, err)
+		}
+
+		toolsVersions[relativePath] = toolsVersion
+	}
+
+	return toolsVersions, nil
+}
+
+func swiftToolsVersion(manifest string) (string, error) {
+	match := swiftToolsVersionPattern.FindStringSubmatch(manifest)
+	if match == nil {
+		return "", fmt.Errorf("swift-tools-version not found")
+	}
+	return match[1], nil
+}
@@ -0,0 +1,78 @@
+package xcodeproj
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_LocalPackageToolsVersions(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithLocalPackage, map[string]string{
+		"../Packages/Networking/Package.swift": `// swift-tools-version: 5.9
+// The swift-tools-version declares the minimum version of Swift required to build this package.
+
+import PackageDescription
+
+let package = Package(
+    name: "Networking"
+)
+`,
+	})
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+
+	toolsVersions, err := project.LocalPackageToolsVersions()
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"Packages/Networking": "5.9"}, toolsVersions)
+
+	project.Path = filepath.Join(filepath.Dir(projectPth), "Missing", "App.xcodeproj")
+	_, err = project.LocalPackageToolsVersions()
+	require.Error(t, err)
+}
+
+func Test_swiftToolsVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "without space",
+			manifest: "// swift-tools-version:5.3\nimport PackageDescription\n",
+			want:     "5.3",
+		},
+		{
+			name:     "with space and language mode",
+			manifest: "// swift-tools-version: 6.0; (experimentalFeatures)\n",
+			want:     "6.0",
+		},
+		{
+			name:     "missing",
+			manifest: "import PackageDescription\n",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := swiftToolsVersion(tt.manifest)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+var pbxprojWithLocalPackage = strings.Replace(pbxprojWithSynchronizedGroups, "/* Begin PBXNativeTarget section */", `/* Begin XCLocalSwiftPackageReference section */
+		D1A0F0502C8B4A0000A1B2C3 /* XCLocalSwiftPackageReference "Packages/Networking" */ = {
+			isa = XCLocalSwiftPackageReference;
+			relativePath = Packages/Networking;
+		};
+/* End XCLocalSwiftPackageReference section */
+
+/* Begin PBXNativeTarget section */`, 1)
@@ -0,0 +1,144 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// swiftLanguageModeSupport is the range of Xcode versions able to build a Swift language mode (SWIFT_VERSION):
+// from the first Xcode version supporting it, until the Xcode version dropping it (exclusive, empty if still supported).
+type swiftLanguageModeSupport struct {
+	minXcodeVersion string
+	maxXcodeVersion string
+}
+
+var swiftLanguageModes = map[string]swiftLanguageModeSupport{
+	"2.3": {minXcodeVersion: "8.0", maxXcodeVersion: "8.3"},
+	"3":   {minXcodeVersion: "8.0", maxXcodeVersion: "10.2"},
+	"4":   {minXcodeVersion: "9.0"},
+	"4.2": {minXcodeVersion: "10.0"},
+	"5":   {minXcodeVersion: "10.2"},
+	"6":   {minXcodeVersion: "16.0"},
+}
+
+// SwiftVersions returns the SWIFT_VERSION build setting of the project's native targets, mapped by the target name.
+// The setting is read from the target's default configuration (falling back to the project's default or the target's first configuration),
+// falling back to the project level setting.
+// Targets without Swift version (like Objective-C only targets) or without configurations are omitted.
+func (p XcodeProj) SwiftVersions() (map[string]string, error) {
+	versions := map[string]string{}
+	for _, target := range p.Proj.Targets {
+		if target.Type != NativeTargetType {
+			continue
+		}
+
+		configuration := p.targetDefaultConfigurationName(target)
+		if configuration == "" {
+			continue
+		}
+
+		buildConfigurations, err := p.targetConfigurationLevels(target.Name, configuration)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, buildConfiguration := range buildConfigurations {
+			if version, err := buildConfiguration.BuildSettings.String("SWIFT_VERSION"); err == nil && version != "" {
+				versions[target.Name] = version
+				break
+			}
+		}
+	}
+	return versions, nil
+}
+
+// CompatibleWithXcode reports whether the given Xcode version (like 15.4) can build the Swift version of each native target,
+// and returns the names of the targets it can not build.
+// An invalid Xcode version (like latest) can not build any of the targets with a Swift version.
+func (p XcodeProj) CompatibleWithXcode(xcodeVersion string) (bool, []string) {
+	_, err := parseVersion(xcodeVersion)
+	validXcodeVersion := err == nil
+
+	versions, err := p.SwiftVersions()
+	if err != nil {
+		return false, nil
+	}
+
+	var incompatibleTargets []string
+	for target, swiftVersion := range versions {
+		if !validXcodeVersion || !xcodeSupportsSwiftVersion(xcodeVersion, swiftVersion) {
+			incompatibleTargets = append(incompatibleTargets, target)
+		}
+	}
+	sort.Strings(incompatibleTargets)
+
+	return len(incompatibleTargets) == 0, incompatibleTargets
+}
+
+// xcodeSupportsSwiftVersion reports whether the Xcode version can build the Swift language mode.
+// Unknown Swift versions are considered unsupported.
+func xcodeSupportsSwiftVersion(xcodeVersion, swiftVersion string) bool {
+	support, ok := swiftLanguageModes[swiftLanguageMode(swiftVersion)]
+	if !ok {
+		return false
+	}
+
+	if compareVersions(xcodeVersion, support.minXcodeVersion) < 0 {
+		return false
+	}
+	return support.maxXcodeVersion == "" || compareVersions(xcodeVersion, support.maxXcodeVersion) < 0
+}
+
+// swiftLanguageMode normalizes the SWIFT_VERSION build setting to the language mode it selects.
+// **Example:** `5.0` **=>** `5`, `4.2` **=>** `4.2`, `3.2` **=>** `3`
+func swiftLanguageMode(swiftVersion string) string {
+	version := strings.TrimSpace(swiftVersion)
+	if _, ok := swiftLanguageModes[version]; ok {
+		return version
+	}
+
+	major := strings.Split(version, ".")[0]
+	if major == "3" || version == major+".0" {
+		return major
+	}
+	return version
+}
+
+// compareVersions compares the dot separated numeric versions, missing components count as 0.
+// Invalid versions compare as 0.0.
+func compareVersions(a, b string) int {
+	aComponents, _ := parseVersion(a)
+	bComponents, _ := parseVersion(b)
+
+	for i := 0; i < len(aComponents) || i < len(bComponents); i++ {
+		var aComponent, bComponent int
+		if i < len(aComponents) {
+			aComponent = aComponents[i]
+		}
+		if i < len(bComponents) {
+			bComponent = bComponents[i]
+		}
+
+		if aComponent != bComponent {
+			if aComponent < bComponent {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func parseVersion(version string) ([]int, error) {
+	var components []int
+	for _, component := range strings.Split(strings.TrimSpace(version), ".") {
+		n, err := strconv.Atoi(component)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version: %s", version)
+		}
+		components = append(components, n)
+	}
+	return components, nil
+}
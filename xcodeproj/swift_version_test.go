@@ -0,0 +1,105 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_xcodeSupportsSwiftVersion(t *testing.T) {
+	tests := []struct {
+		xcodeVersion string
+		swiftVersion string
+		want         bool
+	}{
+		{xcodeVersion: "8.2", swiftVersion: "2.3", want: true},
+		{xcodeVersion: "8.3", swiftVersion: "2.3", want: false},
+		{xcodeVersion: "10.1", swiftVersion: "3.0", want: true},
+		{xcodeVersion: "10.2", swiftVersion: "3.0", want: false},
+		{xcodeVersion: "9.4.1", swiftVersion: "4.2", want: false},
+		{xcodeVersion: "10.0", swiftVersion: "4.2", want: true},
+		{xcodeVersion: "10.1", swiftVersion: "5.0", want: false},
+		{xcodeVersion: "15.4", swiftVersion: "5.0", want: true},
+		{xcodeVersion: "15.4", swiftVersion: "6.0", want: false},
+		{xcodeVersion: "16", swiftVersion: "6.0", want: true},
+		{xcodeVersion: "16.0", swiftVersion: "5.1", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.xcodeVersion+" "+tt.swiftVersion, func(t *testing.T) {
+			require.Equal(t, tt.want, xcodeSupportsSwiftVersion(tt.xcodeVersion, tt.swiftVersion))
+		})
+	}
+}
+
+func TestXcodeProj_CompatibleWithXcode(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithSwiftVersions))
+	require.NoError(t, err)
+
+	versions, err := project.SwiftVersions()
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"App":        "6.0",
+		"Kit":        "3.0",
+		"AppTests":   "5.0",
+		"AppUITests": "5.0",
+	}, versions)
+
+	compatible, incompatibleTargets := project.CompatibleWithXcode("15.4")
+	require.False(t, compatible)
+	require.Equal(t, []string{"App", "Kit"}, incompatibleTargets)
+
+	compatible, incompatibleTargets = project.CompatibleWithXcode("16.0")
+	require.False(t, compatible)
+	require.Equal(t, []string{"Kit"}, incompatibleTargets)
+
+	compatible, incompatibleTargets = project.CompatibleWithXcode("latest")
+	require.False(t, compatible)
+	require.Equal(t, []string{"App", "AppTests", "AppUITests", "Kit"}, incompatibleTargets)
+}
+
+func TestXcodeProj_SwiftVersions_WithoutDefaultConfiguration(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithoutKitDefaultConfiguration))
+	require.NoError(t, err)
+
+	versions, err := project.SwiftVersions()
+	require.NoError(t, err)
+	require.Equal(t, "3.0", versions["Kit"])
+}
+
+// pbxprojWithSwiftVersions sets a different SWIFT_VERSION for the App and Kit targets,
+// the test targets inherit the project level one.
+var pbxprojWithSwiftVersions = newFixtureReplacer(
+	`				SWIFT_OPTIMIZATION_LEVEL = "-O";
+`, `				SWIFT_OPTIMIZATION_LEVEL = "-O";
+				SWIFT_VERSION = 5.0;
+`,
+	`				PRODUCT_NAME = "$(TARGET_NAME)";
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Release;`, `				PRODUCT_NAME = "$(TARGET_NAME)";
+				SWIFT_VERSION = 6.0;
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Release;`,
+	`				PRODUCT_NAME = "$(TARGET_NAME:c99extidentifier)";
+				SKIP_INSTALL = YES;
+			};
+			name = Release;`, `				PRODUCT_NAME = "$(TARGET_NAME:c99extidentifier)";
+				SKIP_INSTALL = YES;
+				SWIFT_VERSION = 3.0;
+			};
+			name = Release;`,
+).Replace(pbxprojWithTestTargets)
+
+// pbxprojWithoutKitDefaultConfiguration removes the defaultConfigurationName of the Kit target's configuration list
+// in pbxprojWithSwiftVersions.
+var pbxprojWithoutKitDefaultConfiguration = newFixtureReplacer(
+	`				E2B0F0752C8B4A0000A1B2C3 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+`, `				E2B0F0752C8B4A0000A1B2C3 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+`,
+).Replace(pbxprojWithSwiftVersions)
@@ -0,0 +1,73 @@
+package xcodeproj
+
+import (
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// dwarfWithDSYMDebugInformationFormat is the DEBUG_INFORMATION_FORMAT value producing dSYM files, used for crash symbolication.
+const dwarfWithDSYMDebugInformationFormat = "dwarf-with-dsym"
+
+// symbolStrippingBuildSettings are the build settings stripping symbols from the target's product when it is copied.
+var symbolStrippingBuildSettings = []string{"COPY_PHASE_STRIP", "STRIP_SWIFT_SYMBOLS"}
+
+// TargetCopyPhaseStrip reports whether the debug symbols are stripped from the target's binaries
+// when they are copied (COPY_PHASE_STRIP). The build setting defaults to YES.
+func (p XcodeProj) TargetCopyPhaseStrip(target, configuration string) (bool, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return false, err
+	}
+
+	return symbolStripping(buildSettings, "COPY_PHASE_STRIP")
+}
+
+// TargetStripSwiftSymbols reports whether the Swift symbols are stripped from the target's binaries
+// when they are copied (STRIP_SWIFT_SYMBOLS). The build setting defaults to YES.
+func (p XcodeProj) TargetStripSwiftSymbols(target, configuration string) (bool, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return false, err
+	}
+
+	return symbolStripping(buildSettings, "STRIP_SWIFT_SYMBOLS")
+}
+
+// AuditSymbolStripping checks the release (non debug) configurations of the project's native targets producing dSYM files
+// (DEBUG_INFORMATION_FORMAT = dwarf-with-dsym), and returns a warning for each symbol stripping build setting explicitly enabled in them.
+// The settings left on their default are not reported.
+func (p XcodeProj) AuditSymbolStripping() ([]Warning, error) {
+	return p.auditReleaseConfigurations(func(target, configuration string, buildSettings serialized.Object) ([]Warning, error) {
+		debugInformationFormat, _, err := resolvedBuildSetting(buildSettings, "DEBUG_INFORMATION_FORMAT")
+		if err != nil || debugInformationFormat != dwarfWithDSYMDebugInformationFormat {
+			return nil, err
+		}
+
+		var warnings []Warning
+		for _, key := range symbolStrippingBuildSettings {
+			enabled, isSet, err := boolBuildSetting(buildSettings, key)
+			if err != nil {
+				return nil, err
+			}
+			if !isSet || !enabled {
+				continue
+			}
+
+			warnings = append(warnings, Warning{
+				Target:        target,
+				Configuration: configuration,
+				BuildSetting:  key,
+				Value:         boolBuildSettingValue(enabled),
+				Message:       "symbols stripped in configuration used for crash symbolication",
+			})
+		}
+		return warnings, nil
+	})
+}
+
+func symbolStripping(buildSettings serialized.Object, key string) (bool, error) {
+	enabled, isSet, err := boolBuildSetting(buildSettings, key)
+	if err != nil {
+		return false, err
+	}
+	return !isSet || enabled, nil
+}
@@ -0,0 +1,70 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_AuditSymbolStripping(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithStrippedSymbols))
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(*project))
+
+	stripped, err := project.TargetCopyPhaseStrip("App", "Release")
+	require.NoError(t, err)
+	require.True(t, stripped)
+
+	stripped, err = project.TargetCopyPhaseStrip("Kit", "Release")
+	require.NoError(t, err)
+	require.False(t, stripped)
+
+	stripped, err = project.TargetStripSwiftSymbols("Kit", "Release")
+	require.NoError(t, err)
+	require.True(t, stripped)
+
+	warnings, err := project.AuditSymbolStripping()
+	require.NoError(t, err)
+	require.Equal(t, []Warning{
+		{
+			Target:        "App",
+			Configuration: "Release",
+			BuildSetting:  "COPY_PHASE_STRIP",
+			Value:         "YES",
+			Message:       "symbols stripped in configuration used for crash symbolication",
+		},
+		{
+			Target:        "App",
+			Configuration: "Release",
+			BuildSetting:  "STRIP_SWIFT_SYMBOLS",
+			Value:         "YES",
+			Message:       "symbols stripped in configuration used for crash symbolication",
+		},
+	}, warnings)
+}
+
+// pbxprojWithStrippedSymbols produces dSYM files in the App and Kit targets' Release configurations,
+// the App target strips the symbols on copy, the Kit target does not.
+var pbxprojWithStrippedSymbols = newFixtureReplacer(
+	`				INFOPLIST_FILE = App/Info.plist;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.App;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Release;`, `				COPY_PHASE_STRIP = YES;
+				DEBUG_INFORMATION_FORMAT = "dwarf-with-dsym";
+				INFOPLIST_FILE = App/Info.plist;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.App;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				STRIP_SWIFT_SYMBOLS = YES;
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Release;`,
+	`				SKIP_INSTALL = YES;
+			};
+			name = Release;`, `				COPY_PHASE_STRIP = NO;
+				DEBUG_INFORMATION_FORMAT = "dwarf-with-dsym";
+				SKIP_INSTALL = YES;
+			};
+			name = Release;`,
+).Replace(pbxprojWithBuildFiles)
@@ -0,0 +1,101 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// SynchronizedGroupExceptions returns the paths excluded from the target's file system synchronized groups
+// (PBXFileSystemSynchronizedRootGroup, introduced in Xcode 16).
+// The returned paths are relative to the project's source root (the synchronized group's path joined with the exception).
+func (p XcodeProj) SynchronizedGroupExceptions(targetName string) ([]string, error) {
+	target, ok := p.Proj.TargetByName(targetName)
+	if !ok {
+		return nil, fmt.Errorf("target not found: %s", targetName)
+	}
+
+	objects, err := p.RawProj.Object("objects")
+	if err != nil {
+		return nil, err
+	}
+
+	rawTarget, err := objects.Object(target.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupIDs, err := rawTarget.StringSlice("fileSystemSynchronizedGroups")
+	if err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var exceptions []string
+	for _, groupID := range groupIDs {
+		groupExceptions, err := synchronizedGroupExceptions(groupID, target.ID, objects)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read synchronized group (%s) exceptions: %s", groupID, err)
+		}
+		exceptions = append(exceptions, groupExceptions...)
+	}
+
+	return exceptions, nil
+}
+
+func synchronizedGroupExceptions(groupID, targetID string, objects serialized.Object) ([]string, error) {
+	group, err := objects.Object(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupPath, err := group.String("path")
+	if err != nil && !serialized.IsKeyNotFoundError(err) {
+		return nil, err
+	}
+
+	exceptionSetIDs, err := group.StringSlice("exceptions")
+	if err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var exceptions []string
+	for _, exceptionSetID := range exceptionSetIDs {
+		exceptionSet, err := objects.Object(exceptionSetID)
+		if err != nil {
+			return nil, err
+		}
+
+		if isa, err := exceptionSet.String("isa"); err != nil {
+			return nil, err
+		} else if isa != "PBXFileSystemSynchronizedBuildFileExceptionSet" {
+			continue
+		}
+
+		if exceptionTargetID, err := exceptionSet.String("target"); err != nil {
+			return nil, err
+		} else if exceptionTargetID != targetID {
+			continue
+		}
+
+		membershipExceptions, err := exceptionSet.StringSlice("membershipExceptions")
+		if err != nil {
+			if serialized.IsKeyNotFoundError(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		for _, membershipException := range membershipExceptions {
+			exceptions = append(exceptions, path.Join(groupPath, membershipException))
+		}
+	}
+
+	return exceptions, nil
+}
@@ -0,0 +1,206 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_SynchronizedGroupExceptions(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithSynchronizedGroups))
+	require.NoError(t, err)
+
+	exceptions, err := project.SynchronizedGroupExceptions("App")
+	require.NoError(t, err)
+	require.Equal(t, []string{"App/Info.plist", "App/Preview Content/Debug.swift"}, exceptions)
+
+	exceptions, err = project.SynchronizedGroupExceptions("AppTests")
+	require.NoError(t, err)
+	require.Equal(t, []string(nil), exceptions)
+
+	_, err = project.SynchronizedGroupExceptions("Missing")
+	require.Error(t, err)
+}
+
+const pbxprojWithSynchronizedGroups = `// !$*UTF8*$!
+{
+	archiveVersion = 1;
+	classes = {
+	};
+	objectVersion = 77;
+	objects = {
+
+/* Begin PBXFileReference section */
+		D1A0F0012C8B4A0000A1B2C3 /* App.app */ = {isa = PBXFileReference; explicitFileType = wrapper.application; includeInIndex = 0; path = App.app; sourceTree = BUILT_PRODUCTS_DIR; };
+		D1A0F0022C8B4A0000A1B2C3 /* AppTests.xctest */ = {isa = PBXFileReference; explicitFileType = wrapper.cfbundle; includeInIndex = 0; path = AppTests.xctest; sourceTree = BUILT_PRODUCTS_DIR; };
+/* End PBXFileReference section */
+
+/* Begin PBXFileSystemSynchronizedBuildFileExceptionSet section */
+		D1A0F0032C8B4A0000A1B2C3 /* Exceptions for "App" folder in "App" target */ = {
+			isa = PBXFileSystemSynchronizedBuildFileExceptionSet;
+			membershipExceptions = (
+				Info.plist,
+				"Preview Content/Debug.swift",
+			);
+			target = D1A0F0102C8B4A0000A1B2C3 /* App */;
+		};
+/* End PBXFileSystemSynchronizedBuildFileExceptionSet section */
+
+/* Begin PBXFileSystemSynchronizedRootGroup section */
+		D1A0F0042C8B4A0000A1B2C3 /* App */ = {
+			isa = PBXFileSystemSynchronizedRootGroup;
+			exceptions = (
+				D1A0F0032C8B4A0000A1B2C3 /* Exceptions for "App" folder in "App" target */,
+			);
+			path = App;
+			sourceTree = "<group>";
+		};
+		D1A0F0052C8B4A0000A1B2C3 /* AppTests */ = {
+			isa = PBXFileSystemSynchronizedRootGroup;
+			path = AppTests;
+			sourceTree = "<group>";
+		};
+/* End PBXFileSystemSynchronizedRootGroup section */
+
+/* Begin PBXGroup section */
+		D1A0F0062C8B4A0000A1B2C3 = {
+			isa = PBXGroup;
+			children = (
+				D1A0F0042C8B4A0000A1B2C3 /* App */,
+				D1A0F0052C8B4A0000A1B2C3 /* AppTests */,
+				D1A0F0072C8B4A0000A1B2C3 /* Products */,
+			);
+			sourceTree = "<group>";
+		};
+		D1A0F0072C8B4A0000A1B2C3 /* Products */ = {
+			isa = PBXGroup;
+			children = (
+				D1A0F0012C8B4A0000A1B2C3 /* App.app */,
+				D1A0F0022C8B4A0000A1B2C3 /* AppTests.xctest */,
+			);
+			name = Products;
+			sourceTree = "<group>";
+		};
+/* End PBXGroup section */
+
+/* Begin PBXNativeTarget section */
+		D1A0F0102C8B4A0000A1B2C3 /* App */ = {
+			isa = PBXNativeTarget;
+			buildConfigurationList = D1A0F0302C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "App" */;
+			buildPhases = (
+			);
+			buildRules = (
+			);
+			dependencies = (
+			);
+			fileSystemSynchronizedGroups = (
+				D1A0F0042C8B4A0000A1B2C3 /* App */,
+			);
+			name = App;
+			productName = App;
+			productReference = D1A0F0012C8B4A0000A1B2C3 /* App.app */;
+			productType = "com.apple.product-type.application";
+		};
+		D1A0F0112C8B4A0000A1B2C3 /* AppTests */ = {
+			isa = PBXNativeTarget;
+			buildConfigurationList = D1A0F0312C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "AppTests" */;
+			buildPhases = (
+			);
+			buildRules = (
+			);
+			dependencies = (
+			);
+			fileSystemSynchronizedGroups = (
+				D1A0F0052C8B4A0000A1B2C3 /* AppTests */,
+			);
+			name = AppTests;
+			productName = AppTests;
+			productReference = D1A0F0022C8B4A0000A1B2C3 /* AppTests.xctest */;
+			productType = "com.apple.product-type.bundle.unit-test";
+		};
+/* End PBXNativeTarget section */
+
+/* Begin PBXProject section */
+		D1A0F0202C8B4A0000A1B2C3 /* Project object */ = {
+			isa = PBXProject;
+			attributes = {
+				BuildIndependentTargetsInParallel = 1;
+				LastSwiftUpdateCheck = 1600;
+				LastUpgradeCheck = 1600;
+			};
+			buildConfigurationList = D1A0F0322C8B4A0000A1B2C3 /* Build configuration list for PBXProject "App" */;
+			developmentRegion = en;
+			hasScannedForEncodings = 0;
+			knownRegions = (
+				en,
+				Base,
+			);
+			mainGroup = D1A0F0062C8B4A0000A1B2C3;
+			minimizedProjectReferenceProxies = 1;
+			preferredProjectObjectVersion = 77;
+			productRefGroup = D1A0F0072C8B4A0000A1B2C3 /* Products */;
+			projectDirPath = "";
+			projectRoot = "";
+			targets = (
+				D1A0F0102C8B4A0000A1B2C3 /* App */,
+				D1A0F0112C8B4A0000A1B2C3 /* AppTests */,
+			);
+		};
+/* End PBXProject section */
+
+/* Begin XCBuildConfiguration section */
+		D1A0F0402C8B4A0000A1B2C3 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.App;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+			};
+			name = Debug;
+		};
+		D1A0F0412C8B4A0000A1B2C3 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.AppTests;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+			};
+			name = Debug;
+		};
+		D1A0F0422C8B4A0000A1B2C3 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				SDKROOT = iphoneos;
+			};
+			name = Debug;
+		};
+/* End XCBuildConfiguration section */
+
+/* Begin XCConfigurationList section */
+		D1A0F0302C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "App" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				D1A0F0402C8B4A0000A1B2C3 /* Debug */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Debug;
+		};
+		D1A0F0312C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "AppTests" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				D1A0F0412C8B4A0000A1B2C3 /* Debug */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Debug;
+		};
+		D1A0F0322C8B4A0000A1B2C3 /* Build configuration list for PBXProject "App" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				D1A0F0422C8B4A0000A1B2C3 /* Debug */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Debug;
+		};
+/* End XCConfigurationList section */
+	};
+	rootObject = D1A0F0202C8B4A0000A1B2C3 /* Project object */;
+}
+`
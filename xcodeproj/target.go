@@ -17,6 +17,20 @@ const (
 	LegacyTargetType    TargetType = "PBXLegacyTarget"
 )
 
+// Product types of installable targets
+const (
+	appProductType     = "com.apple.product-type.application"
+	appClipProductType = "com.apple.product-type.application.on-demand-install-capable"
+)
+
+// Product types of library targets
+const (
+	frameworkProductType       = "com.apple.product-type.framework"
+	staticFrameworkProductType = "com.apple.product-type.framework.static"
+	staticLibraryProductType   = "com.apple.product-type.library.static"
+	dynamicLibraryProductType  = "com.apple.product-type.library.dynamic"
+)
+
 // Target ...
 type Target struct {
 	Type                   TargetType
@@ -61,6 +75,16 @@ func (t Target) DependentExecutableProductTargets(includeUITest bool) []Target {
 	return targets
 }
 
+// hasConfiguration reports whether the target has a build configuration with the given name.
+func (t Target) hasConfiguration(name string) bool {
+	for _, configuration := range t.BuildConfigurationList.BuildConfigurations {
+		if configuration.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 // IsAppProduct ...
 func (t Target) IsAppProduct() bool {
 	return filepath.Ext(t.ProductReference.Path) == ".app"
@@ -76,6 +100,35 @@ func (t Target) IsExecutableProduct() bool {
 	return t.IsAppProduct() || t.IsAppExtensionProduct()
 }
 
+// IsInstallableProduct reports whether the target builds a standalone installable product (an app or an app clip).
+func (t Target) IsInstallableProduct() bool {
+	if !t.IsAppProduct() {
+		return false
+	}
+
+	switch t.ProductType {
+	case "", appProductType, appClipProductType:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsFramework reports whether the target builds a framework (dynamic or static).
+func (t Target) IsFramework() bool {
+	return t.ProductType == frameworkProductType || t.ProductType == staticFrameworkProductType
+}
+
+// IsStaticLibrary reports whether the target builds a static library (.a).
+func (t Target) IsStaticLibrary() bool {
+	return t.ProductType == staticLibraryProductType
+}
+
+// IsDynamicLibrary reports whether the target builds a dynamic library (.dylib).
+func (t Target) IsDynamicLibrary() bool {
+	return t.ProductType == dynamicLibraryProductType
+}
+
 // IsTestProduct ...
 func (t Target) IsTestProduct() bool {
 	return filepath.Ext(t.ProductType) == ".unit-test"
@@ -474,3 +474,27 @@ const expectedNativeTarget = `{
 	},
 	"ProductType": "com.apple.product-type.application"
 }`
+
+func TestTarget_LibraryProductTypes(t *testing.T) {
+	tests := []struct {
+		productType        string
+		wantFramework      bool
+		wantStaticLibrary  bool
+		wantDynamicLibrary bool
+	}{
+		{productType: "com.apple.product-type.application"},
+		{productType: "com.apple.product-type.framework", wantFramework: true},
+		{productType: "com.apple.product-type.framework.static", wantFramework: true},
+		{productType: "com.apple.product-type.library.static", wantStaticLibrary: true},
+		{productType: "com.apple.product-type.library.dynamic", wantDynamicLibrary: true},
+		{productType: "com.apple.product-type.bundle.unit-test"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.productType, func(t *testing.T) {
+			target := Target{ProductType: tt.productType}
+			require.Equal(t, tt.wantFramework, target.IsFramework())
+			require.Equal(t, tt.wantStaticLibrary, target.IsStaticLibrary())
+			require.Equal(t, tt.wantDynamicLibrary, target.IsDynamicLibrary())
+		})
+	}
+}
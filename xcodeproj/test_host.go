@@ -0,0 +1,184 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// TestHostTarget returns the host app target of the test target.
+// The host is looked up by the test target's TestTargetID target attribute, the TEST_TARGET_NAME build setting (UI tests)
+// and the app product referenced by the TEST_HOST build setting (hosted unit tests), in this order.
+func (p XcodeProj) TestHostTarget(testTargetName string) (Target, error) {
+	testTarget, ok := p.Proj.TargetByName(testTargetName)
+	if !ok {
+		return Target{}, fmt.Errorf("target not found: %s", testTargetName)
+	}
+	if !testTarget.IsTestProduct() && !testTarget.IsUITestProduct() {
+		return Target{}, fmt.Errorf("target (%s) is not a test target", testTargetName)
+	}
+
+	host, ok, err := p.testHost(testTarget)
+	if err != nil {
+		return Target{}, err
+	} else if !ok {
+		return Target{}, fmt.Errorf("test host not found for target: %s", testTargetName)
+	}
+	return host, nil
+}
+
+// HostedTestTargets returns the test targets hosted by the app target (see TestHostTarget), in the project's target order:
+// the unit test targets injected into the app and the UI test targets driving it.
+func (p XcodeProj) HostedTestTargets(appTargetName string) ([]Target, error) {
+	appTarget, ok := p.Proj.TargetByName(appTargetName)
+	if !ok {
+		return nil, fmt.Errorf("target not found: %s", appTargetName)
+	}
+	if !appTarget.IsAppProduct() {
+		return nil, fmt.Errorf("target (%s) is not an app target", appTargetName)
+	}
+
+	var testTargets []Target
+	for _, target := range p.Proj.Targets {
+		if !target.IsTestProduct() && !target.IsUITestProduct() {
+			continue
+		}
+
+		host, ok, err := p.testHost(target)
+		if err != nil {
+			return nil, err
+		}
+		if ok && host.ID == appTarget.ID {
+			testTargets = append(testTargets, target)
+		}
+	}
+	return testTargets, nil
+}
+
+// testHost returns the host app target of the test target, the returned bool reports whether the host is found.
+func (p XcodeProj) testHost(testTarget Target) (Target, bool, error) {
+	if hostID, err := p.testTargetID(testTarget.ID); err != nil {
+		return Target{}, false, err
+	} else if hostID != "" {
+		if host, ok := p.Proj.Target(hostID); ok {
+			return host, true, nil
+		}
+	}
+
+	for _, buildConfiguration := range testTarget.BuildConfigurationList.BuildConfigurations {
+		if hostName, err := buildConfiguration.BuildSettings.String("TEST_TARGET_NAME"); err == nil && hostName != "" {
+			if host, ok := p.Proj.TargetByName(hostName); ok {
+				return host, true, nil
+			}
+		}
+
+		if testHost, err := buildConfiguration.BuildSettings.String("TEST_HOST"); err == nil && testHost != "" {
+			if host, ok := p.testHostTarget(testHost); ok {
+				return host, true, nil
+			}
+		}
+	}
+
+	return Target{}, false, nil
+}
+
+// TargetTestHost returns the test target's resolved TEST_HOST build setting, the path of the host app's executable
+// the unit tests are injected into, like /DerivedData/Build/Products/Debug-iphonesimulator/App.app/App.
+// If the TEST_HOST references build settings missing from the test target's build settings, the path is derived from
+// the predicted built product path of the test host (see TestHostTarget and TargetBuiltProductPath).
+// An empty path is returned for targets without a test host.
+func (p XcodeProj) TargetTestHost(target, configuration string) (string, error) {
+	return p.targetTestHostPathBuildSetting(target, configuration, "TEST_HOST")
+}
+
+// TargetBundleLoader returns the test target's resolved BUNDLE_LOADER build setting, the executable
+// the test bundle is linked against (usually the same as the TEST_HOST).
+// It falls back to the test host's executable path like TargetTestHost does.
+// An empty path is returned for targets without a bundle loader.
+func (p XcodeProj) TargetBundleLoader(target, configuration string) (string, error) {
+	return p.targetTestHostPathBuildSetting(target, configuration, "BUNDLE_LOADER")
+}
+
+func (p XcodeProj) targetTestHostPathBuildSetting(target, configuration, key string) (string, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return "", err
+	}
+
+	pth, resolveErr := resolvedPathBuildSetting(buildSettings, key)
+	if resolveErr == nil {
+		return pth, nil
+	}
+
+	testTarget, ok := p.Proj.TargetByName(target)
+	if !ok {
+		return "", resolveErr
+	}
+	host, ok, err := p.testHost(testTarget)
+	if err != nil || !ok {
+		return "", resolveErr
+	}
+
+	return p.targetExecutablePath(host.Name, configuration)
+}
+
+// targetExecutablePath predicts the path of the app target's executable: the built product path (see TargetBuiltProductPath)
+// joined with the bundle's executable folder (empty for iOS apps, Contents/MacOS for macOS apps) and the executable name.
+func (p XcodeProj) targetExecutablePath(target, configuration string) (string, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return "", err
+	}
+
+	productPath, err := builtProductPath(buildSettings)
+	if err != nil {
+		return "", err
+	}
+
+	folder, _, err := resolvedBuildSetting(buildSettings, "BUNDLE_EXECUTABLE_FOLDER_PATH")
+	if err != nil {
+		return "", err
+	}
+
+	name, err := executableName(buildSettings)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(productPath, folder, name), nil
+}
+
+func resolvedPathBuildSetting(buildSettings serialized.Object, key string) (string, error) {
+	pth, found, err := resolvedBuildSetting(buildSettings, key)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %s", key, err)
+	} else if !found || pth == "" {
+		return "", nil
+	}
+	return filepath.Clean(pth), nil
+}
+
+// testTargetID returns the TestTargetID target attribute of the target, or an empty string if not set.
+func (p XcodeProj) testTargetID(targetID string) (string, error) {
+	return p.targetAttributeString(targetID, "TestTargetID")
+}
+
+// testHostTarget returns the app target of the TEST_HOST build setting,
+// like $(BUILT_PRODUCTS_DIR)/App.app/App or $(BUILT_PRODUCTS_DIR)/App.app/Contents/MacOS/App.
+func (p XcodeProj) testHostTarget(testHost string) (Target, bool) {
+	for _, component := range strings.Split(testHost, "/") {
+		if filepath.Ext(component) != ".app" {
+			continue
+		}
+
+		for _, target := range p.Proj.Targets {
+			if target.ProductReference.Path == component {
+				return target, true
+			}
+		}
+		return p.Proj.TargetByName(strings.TrimSuffix(component, ".app"))
+	}
+	return Target{}, false
+}
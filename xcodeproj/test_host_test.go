@@ -0,0 +1,243 @@
+package xcodeproj
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_TestHostTarget(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithTestTargets))
+	require.NoError(t, err)
+
+	host, err := project.TestHostTarget("AppTests")
+	require.NoError(t, err)
+	require.Equal(t, "App", host.Name)
+
+	host, err = project.TestHostTarget("AppUITests")
+	require.NoError(t, err)
+	require.Equal(t, "App", host.Name)
+
+	_, err = project.TestHostTarget("App")
+	require.Error(t, err)
+
+	_, err = project.TestHostTarget("Missing")
+	require.Error(t, err)
+}
+
+func TestXcodeProj_HostedTestTargets(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithTestTargets))
+	require.NoError(t, err)
+
+	testTargets, err := project.HostedTestTargets("App")
+	require.NoError(t, err)
+	var names []string
+	for _, target := range testTargets {
+		names = append(names, target.Name)
+	}
+	require.Equal(t, []string{"AppTests", "AppUITests"}, names)
+
+	_, err = project.HostedTestTargets("Kit")
+	require.EqualError(t, err, "target (Kit) is not an app target")
+
+	_, err = project.HostedTestTargets("Missing")
+	require.EqualError(t, err, "target not found: Missing")
+}
+
+func TestXcodeProj_HostedTestTargets_UnhostedTests(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithUnhostedTests))
+	require.NoError(t, err)
+
+	testTargets, err := project.HostedTestTargets("App")
+	require.NoError(t, err)
+	require.Len(t, testTargets, 1)
+	require.Equal(t, "AppUITests", testTargets[0].Name)
+}
+
+// pbxprojWithUnhostedTests runs the AppTests unit tests without a test host (as logic tests).
+var pbxprojWithUnhostedTests = newFixtureReplacer(
+	`				BUNDLE_LOADER = "$(TEST_HOST)";
+`, ``,
+	`				TEST_HOST = "$(BUILT_PRODUCTS_DIR)/App.app/$(BUNDLE_EXECUTABLE_FOLDER_PATH)/App";
+`, ``,
+).Replace(pbxprojWithTestTargets)
+
+// pbxprojWithTestTargets extends pbxprojWithBuildFiles with a hosted unit test target (AppTests)
+// and a UI test target (AppUITests) testing the App target.
+var pbxprojWithTestTargets = newFixtureReplacer(
+	`/* End PBXFileReference section */`,
+	`		E2B0F0092C8B4A0000A1B2C3 /* AppTests.xctest */ = {isa = PBXFileReference; explicitFileType = wrapper.cfbundle; includeInIndex = 0; path = AppTests.xctest; sourceTree = BUILT_PRODUCTS_DIR; };
+		E2B0F00A2C8B4A0000A1B2C3 /* AppUITests.xctest */ = {isa = PBXFileReference; explicitFileType = wrapper.cfbundle; includeInIndex = 0; path = AppUITests.xctest; sourceTree = BUILT_PRODUCTS_DIR; };
+/* End PBXFileReference section */`,
+
+	`/* End PBXNativeTarget section */`,
+	`		E2B0F0422C8B4A0000A1B2C3 /* AppTests */ = {
+			isa = PBXNativeTarget;
+			buildConfigurationList = E2B0F0632C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "AppTests" */;
+			buildPhases = (
+			);
+			buildRules = (
+			);
+			dependencies = (
+			);
+			name = AppTests;
+			productName = AppTests;
+			productReference = E2B0F0092C8B4A0000A1B2C3 /* AppTests.xctest */;
+			productType = "com.apple.product-type.bundle.unit-test";
+		};
+		E2B0F0432C8B4A0000A1B2C3 /* AppUITests */ = {
+			isa = PBXNativeTarget;
+			buildConfigurationList = E2B0F0642C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "AppUITests" */;
+			buildPhases = (
+			);
+			buildRules = (
+			);
+			dependencies = (
+			);
+			name = AppUITests;
+			productName = AppUITests;
+			productReference = E2B0F00A2C8B4A0000A1B2C3 /* AppUITests.xctest */;
+			productType = "com.apple.product-type.bundle.ui-testing";
+		};
+/* End PBXNativeTarget section */`,
+
+	`				LastUpgradeCheck = 1500;
+`,
+	`				LastUpgradeCheck = 1500;
+				TargetAttributes = {
+					E2B0F0432C8B4A0000A1B2C3 = {
+						CreatedOnToolsVersion = 15.0;
+						TestTargetID = E2B0F0402C8B4A0000A1B2C3;
+					};
+				};
+`,
+
+	`				E2B0F0412C8B4A0000A1B2C3 /* Kit */,
+			);`,
+	`				E2B0F0412C8B4A0000A1B2C3 /* Kit */,
+				E2B0F0422C8B4A0000A1B2C3 /* AppTests */,
+				E2B0F0432C8B4A0000A1B2C3 /* AppUITests */,
+			);`,
+
+	`/* End XCBuildConfiguration section */`,
+	`		E2B0F0762C8B4A0000A1B2C3 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				BUNDLE_LOADER = "$(TEST_HOST)";
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.AppTests;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				TEST_HOST = "$(BUILT_PRODUCTS_DIR)/App.app/$(BUNDLE_EXECUTABLE_FOLDER_PATH)/App";
+			};
+			name = Debug;
+		};
+		E2B0F0772C8B4A0000A1B2C3 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				BUNDLE_LOADER = "$(TEST_HOST)";
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.AppTests;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				TEST_HOST = "$(BUILT_PRODUCTS_DIR)/App.app/$(BUNDLE_EXECUTABLE_FOLDER_PATH)/App";
+			};
+			name = Release;
+		};
+		E2B0F0782C8B4A0000A1B2C3 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.AppUITests;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				TEST_TARGET_NAME = App;
+			};
+			name = Debug;
+		};
+		E2B0F0792C8B4A0000A1B2C3 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.AppUITests;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				TEST_TARGET_NAME = App;
+			};
+			name = Release;
+		};
+/* End XCBuildConfiguration section */`,
+
+	`/* End XCConfigurationList section */`,
+	`		E2B0F0632C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "AppTests" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				E2B0F0762C8B4A0000A1B2C3 /* Debug */,
+				E2B0F0772C8B4A0000A1B2C3 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+		E2B0F0642C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "AppUITests" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				E2B0F0782C8B4A0000A1B2C3 /* Debug */,
+				E2B0F0792C8B4A0000A1B2C3 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+/* End XCConfigurationList section */`,
+).Replace(pbxprojWithBuildFiles)
+
+func TestXcodeProj_TargetTestHost(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithTestTargets))
+	require.NoError(t, err)
+	rawProvider := rawBuildSettingsProvider(*project)
+	project.SetBuildSettingsProvider(func(target, configuration string) (serialized.Object, error) {
+		buildSettings, err := rawProvider(target, configuration)
+		if err != nil {
+			return nil, err
+		}
+		buildSettings = withBuildSetting(buildSettings, "BUILT_PRODUCTS_DIR", "/DerivedData/Build/Products/Debug-iphonesimulator")
+		return withBuildSetting(buildSettings, "BUNDLE_EXECUTABLE_FOLDER_PATH", ""), nil
+	})
+
+	testHost, err := project.TargetTestHost("AppTests", "Debug")
+	require.NoError(t, err)
+	require.Equal(t, "/DerivedData/Build/Products/Debug-iphonesimulator/App.app/App", testHost)
+
+	bundleLoader, err := project.TargetBundleLoader("AppTests", "Debug")
+	require.NoError(t, err)
+	require.Equal(t, testHost, bundleLoader)
+
+	host, err := project.TestHostTarget("AppTests")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Base(filepath.Dir(testHost)), host.ProductReference.Path)
+
+	testHost, err = project.TargetTestHost("AppUITests", "Debug")
+	require.NoError(t, err)
+	require.Equal(t, "", testHost)
+}
+
+func TestXcodeProj_TargetTestHost_HostProduct(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithTestTargets))
+	require.NoError(t, err)
+	rawProvider := rawBuildSettingsProvider(*project)
+	project.SetBuildSettingsProvider(func(target, configuration string) (serialized.Object, error) {
+		buildSettings, err := rawProvider(target, configuration)
+		if err != nil || target != "App" {
+			return buildSettings, err
+		}
+		// only the host app knows its build directory, the test target's TEST_HOST can not be resolved
+		buildSettings = withBuildSetting(buildSettings, "TARGET_BUILD_DIR", "/DerivedData/Build/Products/Debug-iphonesimulator")
+		buildSettings = withBuildSetting(buildSettings, "TARGET_NAME", target)
+		return withBuildSetting(buildSettings, "FULL_PRODUCT_NAME", "$(PRODUCT_NAME).app"), nil
+	})
+
+	productPath, err := project.TargetBuiltProductPath("App", "Debug", "")
+	require.NoError(t, err)
+	require.Equal(t, "/DerivedData/Build/Products/Debug-iphonesimulator/App.app", productPath)
+
+	testHost, err := project.TargetTestHost("AppTests", "Debug")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(productPath, "App"), testHost)
+
+	bundleLoader, err := project.TargetBundleLoader("AppTests", "Debug")
+	require.NoError(t, err)
+	require.Equal(t, testHost, bundleLoader)
+}
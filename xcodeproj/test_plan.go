@@ -0,0 +1,71 @@
+package xcodeproj
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+)
+
+// TestPlan is a test plan (.xctestplan file) of a scheme.
+type TestPlan struct {
+	Path string
+	// Configurations are the names of the test plan's configurations, in the order of the file.
+	Configurations []string
+}
+
+// SchemeTestConfiguration returns the build configuration the scheme's tests run with, the TestAction's buildConfiguration,
+// which can be used to resolve the build settings for testing (like TargetBuildSettings).
+// Test plans do not change the build configuration: their configurations (see SchemeDefaultTestPlan) are sets of test options.
+func (p XcodeProj) SchemeTestConfiguration(schemeName string) (string, error) {
+	scheme, _, err := p.Scheme(schemeName)
+	if err != nil {
+		return "", err
+	}
+
+	if scheme.TestAction.BuildConfiguration == "" {
+		return "", fmt.Errorf("no test configuration set in scheme: %s", schemeName)
+	}
+	return scheme.TestAction.BuildConfiguration, nil
+}
+
+// SchemeDefaultTestPlan returns the test plan the scheme's tests run with by default,
+// or nil if the scheme does not use test plans.
+func (p XcodeProj) SchemeDefaultTestPlan(schemeName string) (*TestPlan, error) {
+	scheme, _, err := p.Scheme(schemeName)
+	if err != nil {
+		return nil, err
+	}
+
+	reference, ok := scheme.TestAction.DefaultTestPlan()
+	if !ok {
+		return nil, nil
+	}
+
+	pth, err := reference.AbsPath(filepath.Dir(p.Path))
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := fileutil.ReadBytesFromFile(pth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read test plan: %s", err)
+	}
+
+	var testPlan struct {
+		Configurations []struct {
+			Name string `json:"name"`
+		} `json:"configurations"`
+	}
+	if err := json.Unmarshal(content, &testPlan); err != nil {
+		return nil, fmt.Errorf("failed to parse test plan (%s): %s", pth, err)
+	}
+
+	var configurations []string
+	for _, configuration := range testPlan.Configurations {
+		configurations = append(configurations, configuration.Name)
+	}
+
+	return &TestPlan{Path: pth, Configurations: configurations}, nil
+}
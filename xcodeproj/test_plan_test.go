@@ -0,0 +1,99 @@
+package xcodeproj
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_SchemeTestConfiguration(t *testing.T) {
+	projectPth := createTmpProject(t, "Target.xcodeproj", pbxprojWithouthTargetAttributes, map[string]string{
+		"xcshareddata/xcschemes/Target.xcscheme":           targetSchemeContent,
+		"xcshareddata/xcschemes/Target-TestPlans.xcscheme": targetSchemeWithTestPlansContent,
+		"../Target.xctestplan":                             targetTestPlanContent,
+	})
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+
+	configuration, err := project.SchemeTestConfiguration("Target")
+	require.NoError(t, err)
+	require.Equal(t, "Debug", configuration)
+
+	configuration, err = project.SchemeTestConfiguration("Target-TestPlans")
+	require.NoError(t, err)
+	require.Equal(t, "Staging", configuration)
+
+	testPlan, err := project.SchemeDefaultTestPlan("Target")
+	require.NoError(t, err)
+	require.Nil(t, testPlan)
+
+	testPlan, err = project.SchemeDefaultTestPlan("Target-TestPlans")
+	require.NoError(t, err)
+	require.Equal(t, &TestPlan{
+		Path:           filepath.Join(filepath.Dir(projectPth), "Target.xctestplan"),
+		Configurations: []string{"English", "German"},
+	}, testPlan)
+
+	_, err = project.SchemeTestConfiguration("Missing")
+	require.Error(t, err)
+}
+
+// targetSchemeWithTestPlansContent runs the tests with the Target.xctestplan by default (next to the project),
+// its TestAction's buildConfiguration (Staging) is independent of the test plan's configurations (English and German).
+var targetSchemeWithTestPlansContent = newFixtureReplacer(
+	`      buildConfiguration = "Debug"
+      selectedDebuggerIdentifier = "Xcode.DebuggerFoundation.Debugger.LLDB"
+      selectedLauncherIdentifier = "Xcode.DebuggerFoundation.Launcher.LLDB"
+      shouldUseLaunchSchemeArgsEnv = "YES">
+      <Testables>
+      </Testables>`,
+	`      buildConfiguration = "Staging"
+      selectedDebuggerIdentifier = "Xcode.DebuggerFoundation.Debugger.LLDB"
+      selectedLauncherIdentifier = "Xcode.DebuggerFoundation.Launcher.LLDB"
+      shouldUseLaunchSchemeArgsEnv = "YES">
+      <TestPlans>
+         <TestPlanReference
+            reference = "container:Smoke.xctestplan">
+         </TestPlanReference>
+         <TestPlanReference
+            reference = "container:Target.xctestplan"
+            default = "YES">
+         </TestPlanReference>
+      </TestPlans>
+      <Testables>
+      </Testables>`,
+).Replace(targetSchemeContent)
+
+const targetTestPlanContent = `{
+  "configurations" : [
+    {
+      "id" : "0F1D2C8A-7B3E-4A6F-9C21-5E8D4B7A1F30",
+      "name" : "English",
+      "options" : {
+        "language" : "en"
+      }
+    },
+    {
+      "id" : "6B2E9F14-3C7A-4D58-8E01-A4C9D2F7B635",
+      "name" : "German",
+      "options" : {
+        "language" : "de"
+      }
+    }
+  ],
+  "defaultOptions" : {
+    "codeCoverage" : false
+  },
+  "testTargets" : [
+    {
+      "target" : {
+        "containerPath" : "container:Target.xcodeproj",
+        "identifier" : "13BD62FD256BE6D000F72361",
+        "name" : "Target"
+      }
+    }
+  ],
+  "version" : 1
+}
+`
@@ -0,0 +1,72 @@
+package xcodeproj
+
+import (
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// Node is an object of the project in the object tree returned by XcodeProj.Tree.
+type Node struct {
+	ID string
+	// ISA is the object's type, like PBXGroup or PBXNativeTarget.
+	ISA string
+	// Name is the human readable name of the object, see XcodeProj.ObjectName.
+	Name string
+	// Key is the key of the parent object referencing this object, like children or targets.
+	Key string
+	// Children are the objects referenced by this object, ordered by the referencing key, then by the order of the references.
+	// The children of an object referenced multiple times (like a file reference used by a build file)
+	// are only listed at its first occurrence, in depth-first order.
+	Children []*Node
+}
+
+// Tree returns the project's objects as a tree linked by the object references, rooted at the PBXProject object.
+// It is meant for inspecting the project, callers can walk from the project through its groups,
+// targets, build phases and build files down to the file references.
+func (p XcodeProj) Tree() *Node {
+	objects, err := p.RawProj.Object("objects")
+	if err != nil {
+		return nil
+	}
+
+	return p.treeNode(p.Proj.ID, "", objects, map[string]bool{})
+}
+
+func (p XcodeProj) treeNode(id, key string, objects serialized.Object, visited map[string]bool) *Node {
+	object, err := objects.Object(id)
+	if err != nil {
+		return nil
+	}
+
+	node := &Node{ID: id, Name: p.ObjectName(id), Key: key}
+	node.ISA, _ = object.String("isa")
+
+	if visited[id] {
+		return node
+	}
+	visited[id] = true
+
+	for _, childKey := range sortedKeys(object) {
+		var childIDs []string
+		switch value := object[childKey].(type) {
+		case string:
+			childIDs = []string{value}
+		case []interface{}:
+			for _, item := range value {
+				if childID, ok := item.(string); ok {
+					childIDs = append(childIDs, childID)
+				}
+			}
+		}
+
+		for _, childID := range childIDs {
+			if _, ok := objects[childID]; !ok {
+				continue
+			}
+			if child := p.treeNode(childID, childKey, objects, visited); child != nil {
+				node.Children = append(node.Children, child)
+			}
+		}
+	}
+
+	return node
+}
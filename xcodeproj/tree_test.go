@@ -0,0 +1,41 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_Tree(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithBuildFiles))
+	require.NoError(t, err)
+
+	root := project.Tree()
+	require.NotNil(t, root)
+	require.Equal(t, project.Proj.ID, root.ID)
+	require.Equal(t, "PBXProject", root.ISA)
+
+	mainGroup := treeChild(t, root, "mainGroup", "E2B0F0302C8B4A0000A1B2C3")
+	require.Equal(t, "PBXGroup", mainGroup.ISA)
+
+	appGroup := treeChild(t, mainGroup, "children", "E2B0F0312C8B4A0000A1B2C3")
+	require.Equal(t, "App", appGroup.Name)
+
+	fileReference := treeChild(t, appGroup, "children", "E2B0F0032C8B4A0000A1B2C3")
+	require.Equal(t, Node{
+		ID:   "E2B0F0032C8B4A0000A1B2C3",
+		ISA:  "PBXFileReference",
+		Name: "AppDelegate.m",
+		Key:  "children",
+	}, *fileReference)
+}
+
+func treeChild(t *testing.T, node *Node, key, id string) *Node {
+	for _, child := range node.Children {
+		if child.Key == key && child.ID == id {
+			return child
+		}
+	}
+	require.FailNowf(t, "child not found", "%s (%s) of %s", id, key, node.ID)
+	return nil
+}
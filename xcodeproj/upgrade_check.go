@@ -0,0 +1,31 @@
+package xcodeproj
+
+import "github.com/bitrise-io/xcode-project/serialized"
+
+// upgradeCheckAttributes are the project attributes holding the version of Xcode (like 1500 for Xcode 15.0)
+// the project was last checked with for recommended settings, Swift updates and test settings.
+var upgradeCheckAttributes = []string{"LastUpgradeCheck", "LastSwiftUpdateCheck", "LastTestingUpgradeCheck"}
+
+// UpgradeCheckAttributes returns the project's LastUpgradeCheck, LastSwiftUpdateCheck and LastTestingUpgradeCheck attributes,
+// mapped by the attribute name. Attributes which are not set are omitted.
+func (p XcodeProj) UpgradeCheckAttributes() (map[string]string, error) {
+	attributes, err := p.Attributes()
+	if err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+
+	versions := map[string]string{}
+	for _, key := range upgradeCheckAttributes {
+		version, err := optionalString(attributes, key)
+		if err != nil {
+			return nil, err
+		}
+		if version != "" {
+			versions[key] = version
+		}
+	}
+	return versions, nil
+}
@@ -0,0 +1,39 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_UpgradeCheckAttributes(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithUpgradeChecks))
+	require.NoError(t, err)
+
+	attributes, err := project.UpgradeCheckAttributes()
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"LastUpgradeCheck":        "1500",
+		"LastSwiftUpdateCheck":    "1430",
+		"LastTestingUpgradeCheck": "1510",
+	}, attributes)
+
+	project, err = parsePBXProjContent([]byte(pbxprojWithBuildFiles))
+	require.NoError(t, err)
+
+	attributes, err = project.UpgradeCheckAttributes()
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"LastUpgradeCheck": "1500"}, attributes)
+}
+
+// pbxprojWithUpgradeChecks sets all of the upgrade check project attributes.
+var pbxprojWithUpgradeChecks = newFixtureReplacer(
+	`			attributes = {
+				LastUpgradeCheck = 1500;
+			};`,
+	`			attributes = {
+				LastSwiftUpdateCheck = 1430;
+				LastTestingUpgradeCheck = 1510;
+				LastUpgradeCheck = 1500;
+			};`,
+).Replace(pbxprojWithBuildFiles)
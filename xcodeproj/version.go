@@ -0,0 +1,257 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// appleGenericVersioningSystem is the VERSIONING_SYSTEM value of projects using Apple Generic Versioning (agvtool).
+const appleGenericVersioningSystem = "apple-generic"
+
+// versioningBuildSettings are the build settings the version and the build number of a target are read from.
+var versioningBuildSettings = []string{"MARKETING_VERSION", "CURRENT_PROJECT_VERSION", "VERSIONING_SYSTEM"}
+
+// TargetVersion returns the target's marketing version, like `agvtool what-marketing-version` does.
+// The value is read from the Info.plist's CFBundleShortVersionString key, falling back to the MARKETING_VERSION build setting.
+func (p XcodeProj) TargetVersion(target, configuration string) (string, error) {
+	buildSettings, infoPlist, err := p.targetVersioningBuildSettingsAndInformationPropertyList(target, configuration)
+	if err != nil {
+		return "", err
+	}
+
+	return marketingVersion(infoPlist, buildSettings)
+}
+
+// TargetBuildNumber returns the target's build number, like `agvtool what-version` does.
+// Targets using Apple Generic Versioning (VERSIONING_SYSTEM = apple-generic) report the CURRENT_PROJECT_VERSION build setting,
+// other targets report the Info.plist's CFBundleVersion key, falling back to the CURRENT_PROJECT_VERSION build setting.
+// The VERSION_INFO_PREFIX and VERSION_INFO_SUFFIX build settings only decorate the generated version symbol's name,
+// so they are not part of the returned build number.
+func (p XcodeProj) TargetBuildNumber(target, configuration string) (string, error) {
+	buildSettings, infoPlist, err := p.targetVersioningBuildSettingsAndInformationPropertyList(target, configuration)
+	if err != nil {
+		return "", err
+	}
+
+	return buildNumber(infoPlist, buildSettings)
+}
+
+// BumpBuildNumber increments the target's build number (as returned by TargetBuildNumber) by one and returns the new value.
+// The last dot separated component of the build number is incremented (42 becomes 43, 1.2.3 becomes 1.2.4),
+// an error is returned if it is not numeric.
+// If the build number is set in the Info.plist (not by a build setting reference), the Info.plist file is updated,
+// otherwise the target's CURRENT_PROJECT_VERSION build setting is set and the project needs to be saved to persist the change.
+func (p XcodeProj) BumpBuildNumber(target, configuration string) (string, error) {
+	buildSettings, infoPlist, err := p.targetVersioningBuildSettingsAndInformationPropertyList(target, configuration)
+	if err != nil {
+		return "", err
+	}
+
+	current, err := buildNumber(infoPlist, buildSettings)
+	if err != nil {
+		return "", err
+	}
+
+	bumped, err := incrementBuildNumber(current)
+	if err != nil {
+		return "", err
+	}
+
+	versioningSystem, err := buildSettings.String("VERSIONING_SYSTEM")
+	if err != nil && !serialized.IsKeyNotFoundError(err) {
+		return "", err
+	}
+
+	if infoPlist != nil && versioningSystem != appleGenericVersioningSystem {
+		if rawBuildNumber, err := infoPlist.String("CFBundleVersion"); err == nil && rawBuildNumber != "" && !strings.Contains(rawBuildNumber, "$") {
+			pth, err := p.buildSettingsPath(buildSettings, "INFOPLIST_FILE")
+			if err != nil {
+				return "", err
+			}
+			return bumped, updatePlistFile(pth, serialized.Object{"CFBundleVersion": bumped})
+		}
+	}
+
+	return bumped, p.setTargetBuildSetting(target, configuration, "CURRENT_PROJECT_VERSION", bumped)
+}
+
+// CheckVersionConsistency reports whether the Info.plist's CFBundleShortVersionString (the version displayed to the users)
+// and the MARKETING_VERSION build setting agree, after resolving the build setting references.
+// The details describe the compared values or the mismatch.
+// The versions are consistent if either of them is not set, since there is nothing to disagree with then,
+// and inconsistent if the CFBundleShortVersionString references an unset build setting.
+func (p XcodeProj) CheckVersionConsistency(target, configuration string) (bool, string, error) {
+	buildSettings, infoPlist, err := p.targetVersioningBuildSettingsAndInformationPropertyList(target, configuration)
+	if err != nil {
+		return false, "", err
+	}
+
+	return versionConsistency(infoPlist, buildSettings)
+}
+
+func versionConsistency(infoPlist, buildSettings serialized.Object) (bool, string, error) {
+	marketingVersion, marketingVersionFound, err := resolvedBuildSetting(buildSettings, "MARKETING_VERSION")
+	if err != nil {
+		return false, "", err
+	}
+
+	shortVersion, shortVersionFound, err := informationPropertyListString(infoPlist, buildSettings, "CFBundleShortVersionString")
+	if err != nil {
+		rawShortVersion, _ := infoPlist.String("CFBundleShortVersionString")
+		return false, fmt.Sprintf("CFBundleShortVersionString (%s) can not be resolved: %s", rawShortVersion, err), nil
+	}
+
+	switch {
+	case !shortVersionFound && !marketingVersionFound:
+		return true, "neither CFBundleShortVersionString nor MARKETING_VERSION is set", nil
+	case !shortVersionFound:
+		return true, fmt.Sprintf("CFBundleShortVersionString is not set, MARKETING_VERSION is %s", marketingVersion), nil
+	case !marketingVersionFound:
+		return true, fmt.Sprintf("MARKETING_VERSION is not set, CFBundleShortVersionString is %s", shortVersion), nil
+	case shortVersion != marketingVersion:
+		return false, fmt.Sprintf("CFBundleShortVersionString (%s) differs from MARKETING_VERSION (%s)", shortVersion, marketingVersion), nil
+	default:
+		return true, fmt.Sprintf("CFBundleShortVersionString and MARKETING_VERSION are %s", shortVersion), nil
+	}
+}
+
+// targetVersioningBuildSettingsAndInformationPropertyList returns the target's build settings and Info.plist,
+// with the versioning build settings the target does not set (or sets to $(inherited)) inherited from the project's build configuration.
+// The build settings read by xcodebuild already contain the inherited values,
+// but the ones of a BuildSettingsProvider may be the target level settings only.
+func (p XcodeProj) targetVersioningBuildSettingsAndInformationPropertyList(target, configuration string) (serialized.Object, serialized.Object, error) {
+	buildSettings, err := p.TargetBuildSettings(target, configuration)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if configuration == "" {
+		if t, ok := p.Proj.TargetByName(target); ok {
+			configuration = t.BuildConfigurationList.DefaultConfigurationName
+		}
+	}
+	if buildConfigurations, err := p.targetConfigurationLevels(target, configuration); err == nil {
+		if buildSettings, err = withInheritedBuildSettings(buildSettings, buildConfigurations, versioningBuildSettings); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	infoPlist, err := p.informationPropertyList(buildSettings)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return buildSettings, infoPlist, nil
+}
+
+// withInheritedBuildSettings returns a copy of the build settings with the given keys, which the build settings
+// do not set or set to the inherited value, read from the build configuration levels (see targetConfigurationLevels).
+func withInheritedBuildSettings(buildSettings serialized.Object, buildConfigurations []BuildConfiguration, keys []string) (serialized.Object, error) {
+	inherited := serialized.Object{}
+	for key, value := range buildSettings {
+		inherited[key] = value
+	}
+
+	for _, key := range keys {
+		if value, err := buildSettings.String(key); err == nil && !containsInheritedReference(value) {
+			continue
+		}
+
+		if buildConfigurationLevelsString(buildConfigurations, key) == "" {
+			continue
+		}
+		entries, err := inheritedBuildSettingList(buildConfigurations, key)
+		if err != nil {
+			return nil, err
+		}
+		inherited[key] = strings.Join(entries, " ")
+	}
+	return inherited, nil
+}
+
+func containsInheritedReference(value string) bool {
+	for _, entry := range splitBuildSettingList(value) {
+		if isInheritedReference(entry) {
+			return true
+		}
+	}
+	return false
+}
+
+// incrementBuildNumber increments the last dot separated, numeric component of the build number, keeping its zero padding.
+func incrementBuildNumber(buildNumber string) (string, error) {
+	components := strings.Split(buildNumber, ".")
+	last := components[len(components)-1]
+
+	number, err := strconv.ParseUint(last, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("build number (%s) can not be incremented: the last component (%s) is not numeric", buildNumber, last)
+	}
+
+	components[len(components)-1] = fmt.Sprintf("%0*d", len(last), number+1)
+	return strings.Join(components, "."), nil
+}
+
+func marketingVersion(infoPlist, buildSettings serialized.Object) (string, error) {
+	version, found, err := informationPropertyListString(infoPlist, buildSettings, "CFBundleShortVersionString")
+	if err != nil {
+		return "", err
+	} else if found {
+		return version, nil
+	}
+
+	version, _, err = resolvedBuildSetting(buildSettings, "MARKETING_VERSION")
+	return version, err
+}
+
+func buildNumber(infoPlist, buildSettings serialized.Object) (string, error) {
+	versioningSystem, err := buildSettings.String("VERSIONING_SYSTEM")
+	if err != nil && !serialized.IsKeyNotFoundError(err) {
+		return "", err
+	}
+
+	if versioningSystem == appleGenericVersioningSystem {
+		version, found, err := resolvedBuildSetting(buildSettings, "CURRENT_PROJECT_VERSION")
+		if err != nil {
+			return "", err
+		} else if !found {
+			return "", fmt.Errorf("CURRENT_PROJECT_VERSION build setting not found, while VERSIONING_SYSTEM is %s", appleGenericVersioningSystem)
+		}
+		return version, nil
+	}
+
+	version, found, err := informationPropertyListString(infoPlist, buildSettings, "CFBundleVersion")
+	if err != nil {
+		return "", err
+	} else if found {
+		return version, nil
+	}
+
+	version, _, err = resolvedBuildSetting(buildSettings, "CURRENT_PROJECT_VERSION")
+	return version, err
+}
+
+// resolvedBuildSetting returns the build setting's value with the referenced build settings resolved.
+// The returned bool reports whether the build setting was found.
+func resolvedBuildSetting(buildSettings serialized.Object, key string) (string, bool, error) {
+	value, err := buildSettings.String(key)
+	if err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+
+	if strings.Contains(value, "$") {
+		resolved, err := Resolve(value, buildSettings)
+		if err != nil {
+			return "", false, err
+		}
+		return resolved, true, nil
+	}
+
+	return value, true, nil
+}
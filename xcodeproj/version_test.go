@@ -0,0 +1,345 @@
+package xcodeproj
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_buildNumber(t *testing.T) {
+	tests := []struct {
+		name          string
+		infoPlist     string
+		buildSettings serialized.Object
+		want          string
+		wantErr       bool
+	}{
+		{
+			name:      "apple-generic versioning",
+			infoPlist: appleGenericVersioningInfoPlist,
+			buildSettings: serialized.Object{
+				"VERSIONING_SYSTEM":       "apple-generic",
+				"CURRENT_PROJECT_VERSION": "42",
+				"VERSION_INFO_PREFIX":     "Prefix",
+				"VERSION_INFO_SUFFIX":     "Suffix",
+			},
+			want: "42",
+		},
+		{
+			name:      "apple-generic versioning without current project version",
+			infoPlist: appleGenericVersioningInfoPlist,
+			buildSettings: serialized.Object{
+				"VERSIONING_SYSTEM": "apple-generic",
+			},
+			wantErr: true,
+		},
+		{
+			name:      "no versioning system",
+			infoPlist: storyboardAppInfoPlist,
+			buildSettings: serialized.Object{
+				"CURRENT_PROJECT_VERSION": "42",
+			},
+			want: "42",
+		},
+		{
+			name:      "Info.plist bundle version",
+			infoPlist: appleGenericVersioningInfoPlist,
+			buildSettings: serialized.Object{
+				"CURRENT_PROJECT_VERSION": "42",
+			},
+			want: "42",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			infoPlist := unmarshalInformationPropertyList(t, tt.infoPlist)
+
+			got, err := buildNumber(infoPlist, tt.buildSettings)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_incrementBuildNumber(t *testing.T) {
+	tests := []struct {
+		buildNumber string
+		want        string
+		wantErr     bool
+	}{
+		{buildNumber: "42", want: "43"},
+		{buildNumber: "1.2.3", want: "1.2.4"},
+		{buildNumber: "1.2.9", want: "1.2.10"},
+		{buildNumber: "2024.009", want: "2024.010"},
+		{buildNumber: "1.2.beta", wantErr: true},
+		{buildNumber: "build", wantErr: true},
+		{buildNumber: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.buildNumber, func(t *testing.T) {
+			got, err := incrementBuildNumber(tt.buildNumber)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestXcodeProj_BumpBuildNumber(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithCurrentProjectVersion, map[string]string{
+		"../App/Info.plist": strings.Replace(appleGenericVersioningInfoPlist, "$(CURRENT_PROJECT_VERSION)", "1.2.3", 1),
+		"../Kit/Info.plist": appleGenericVersioningInfoPlist,
+	})
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(project))
+
+	// the Info.plist's CFBundleVersion is updated
+	bumped, err := project.BumpBuildNumber("App", "Release")
+	require.NoError(t, err)
+	require.Equal(t, "1.2.4", bumped)
+	buildNumber, err := project.TargetBuildNumber("App", "Release")
+	require.NoError(t, err)
+	require.Equal(t, "1.2.4", buildNumber)
+
+	// the CURRENT_PROJECT_VERSION build setting is updated
+	bumped, err = project.BumpBuildNumber("Kit", "Release")
+	require.NoError(t, err)
+	require.Equal(t, "43", bumped)
+	buildNumber, err = project.TargetBuildNumber("Kit", "Release")
+	require.NoError(t, err)
+	require.Equal(t, "43", buildNumber)
+	buildNumber, err = project.TargetBuildNumber("Kit", "Debug")
+	require.NoError(t, err)
+	require.Equal(t, "42", buildNumber)
+}
+
+func TestXcodeProj_TargetBuildNumber_AppleGenericVersioning(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithAppleGenericVersioning, map[string]string{
+		"../Kit/Info.plist": strings.Replace(appleGenericVersioningInfoPlist, "$(CURRENT_PROJECT_VERSION)", "7", 1),
+	})
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(project))
+
+	// like agvtool what-version, the hardcoded CFBundleVersion is ignored
+	buildNumber, err := project.TargetBuildNumber("Kit", "Release")
+	require.NoError(t, err)
+	require.Equal(t, "42", buildNumber)
+
+	// the CURRENT_PROJECT_VERSION build setting is updated, not the Info.plist
+	bumped, err := project.BumpBuildNumber("Kit", "Release")
+	require.NoError(t, err)
+	require.Equal(t, "43", bumped)
+
+	pth, err := project.TargetInformationPropertyListPath("Kit", "Release")
+	require.NoError(t, err)
+	infoPlist, _, err := ReadPlistFile(pth)
+	require.NoError(t, err)
+	require.Equal(t, "7", infoPlist["CFBundleVersion"])
+}
+
+// pbxprojWithCurrentProjectVersion sets the CURRENT_PROJECT_VERSION build setting of the Kit target.
+var pbxprojWithCurrentProjectVersion = newFixtureReplacer(
+	`				DEFINES_MODULE = YES;
+`, `				CURRENT_PROJECT_VERSION = 42;
+				DEFINES_MODULE = YES;
+`,
+).Replace(pbxprojWithBuildFiles)
+
+// pbxprojWithAppleGenericVersioning extends pbxprojWithCurrentProjectVersion with Apple Generic Versioning of the Kit target.
+var pbxprojWithAppleGenericVersioning = newFixtureReplacer(
+	`				CURRENT_PROJECT_VERSION = 42;
+`, `				CURRENT_PROJECT_VERSION = 42;
+				VERSIONING_SYSTEM = "apple-generic";
+`,
+).Replace(pbxprojWithCurrentProjectVersion)
+
+func TestXcodeProj_TargetVersion_ProjectLevelVersioning(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithProjectLevelVersioning, map[string]string{
+		"../App/Info.plist": appleGenericVersioningInfoPlist,
+		"../Kit/Info.plist": appleGenericVersioningInfoPlist,
+	})
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(project))
+
+	for _, configuration := range []string{"Debug", "Release", ""} {
+		version, err := project.TargetVersion("App", configuration)
+		require.NoError(t, err)
+		require.Equal(t, "2.1.0", version, configuration)
+
+		buildNumber, err := project.TargetBuildNumber("App", configuration)
+		require.NoError(t, err)
+		require.Equal(t, "7", buildNumber, configuration)
+	}
+
+	// the target level MARKETING_VERSION overrides the project level one
+	version, err := project.TargetVersion("Kit", "Release")
+	require.NoError(t, err)
+	require.Equal(t, "3.0.0", version)
+
+	buildNumber, err := project.TargetBuildNumber("Kit", "Release")
+	require.NoError(t, err)
+	require.Equal(t, "7", buildNumber)
+
+	// reading the inherited settings does not modify the target's build settings
+	buildSettings, err := project.TargetBuildSettings("App", "Release")
+	require.NoError(t, err)
+	require.Equal(t, "$(inherited)", buildSettings["MARKETING_VERSION"])
+	require.NotContains(t, buildSettings, "CURRENT_PROJECT_VERSION")
+}
+
+// pbxprojWithProjectLevelVersioning sets the MARKETING_VERSION and CURRENT_PROJECT_VERSION build settings
+// of the project's build configurations, the App target inherits the MARKETING_VERSION explicitly ($(inherited))
+// and the Kit target overrides it.
+var pbxprojWithProjectLevelVersioning = newFixtureReplacer(
+	`				DEBUG_INFORMATION_FORMAT = `, `				CURRENT_PROJECT_VERSION = 7;
+				DEBUG_INFORMATION_FORMAT = `,
+	`				IPHONEOS_DEPLOYMENT_TARGET = 15.0;
+`, `				IPHONEOS_DEPLOYMENT_TARGET = 15.0;
+				MARKETING_VERSION = 2.1.0;
+`,
+	`				INFOPLIST_FILE = App/Info.plist;
+`, `				INFOPLIST_FILE = App/Info.plist;
+				MARKETING_VERSION = "$(inherited)";
+`,
+	`				INFOPLIST_FILE = Kit/Info.plist;
+`, `				INFOPLIST_FILE = Kit/Info.plist;
+				MARKETING_VERSION = 3.0.0;
+`,
+).Replace(pbxprojWithBuildFiles)
+
+func TestXcodeProj_CheckVersionConsistency(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithProjectLevelVersioning, map[string]string{
+		"../App/Info.plist": strings.Replace(appleGenericVersioningInfoPlist, "$(MARKETING_VERSION)", "1.0", 1),
+		"../Kit/Info.plist": appleGenericVersioningInfoPlist,
+	})
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(project))
+
+	consistent, details, err := project.CheckVersionConsistency("App", "Release")
+	require.NoError(t, err)
+	require.False(t, consistent)
+	require.Equal(t, "CFBundleShortVersionString (1.0) differs from MARKETING_VERSION (2.1.0)", details)
+
+	consistent, details, err = project.CheckVersionConsistency("Kit", "Release")
+	require.NoError(t, err)
+	require.True(t, consistent)
+	require.Equal(t, "CFBundleShortVersionString and MARKETING_VERSION are 3.0.0", details)
+}
+
+func Test_versionConsistency(t *testing.T) {
+	tests := []struct {
+		name           string
+		infoPlist      string
+		buildSettings  serialized.Object
+		wantConsistent bool
+		wantDetails    string
+	}{
+		{
+			name:           "generated Info.plist",
+			buildSettings:  serialized.Object{"MARKETING_VERSION": "1.2.3"},
+			wantConsistent: true,
+			wantDetails:    "CFBundleShortVersionString is not set, MARKETING_VERSION is 1.2.3",
+		},
+		{
+			name:           "generated Info.plist key",
+			buildSettings:  serialized.Object{"MARKETING_VERSION": "1.2.3", "INFOPLIST_KEY_CFBundleShortVersionString": "1.2"},
+			wantConsistent: false,
+			wantDetails:    "CFBundleShortVersionString (1.2) differs from MARKETING_VERSION (1.2.3)",
+		},
+		{
+			name:           "Info.plist version without build setting",
+			infoPlist:      strings.Replace(appleGenericVersioningInfoPlist, "$(MARKETING_VERSION)", "1.0", 1),
+			buildSettings:  serialized.Object{},
+			wantConsistent: true,
+			wantDetails:    "MARKETING_VERSION is not set, CFBundleShortVersionString is 1.0",
+		},
+		{
+			name:           "Info.plist referencing unset build setting",
+			infoPlist:      appleGenericVersioningInfoPlist,
+			buildSettings:  serialized.Object{},
+			wantConsistent: false,
+		},
+		{
+			name:           "no version",
+			buildSettings:  serialized.Object{},
+			wantConsistent: true,
+			wantDetails:    "neither CFBundleShortVersionString nor MARKETING_VERSION is set",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			infoPlist := unmarshalInformationPropertyList(t, tt.infoPlist)
+
+			consistent, details, err := versionConsistency(infoPlist, tt.buildSettings)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantConsistent, consistent)
+			if tt.wantDetails != "" {
+				require.Equal(t, tt.wantDetails, details)
+			} else {
+				require.Contains(t, details, "can not be resolved")
+			}
+		})
+	}
+}
+
+func Test_marketingVersion(t *testing.T) {
+	tests := []struct {
+		name          string
+		infoPlist     string
+		buildSettings serialized.Object
+		want          string
+	}{
+		{
+			name:      "Info.plist short version string",
+			infoPlist: appleGenericVersioningInfoPlist,
+			buildSettings: serialized.Object{
+				"MARKETING_VERSION": "1.2.3",
+			},
+			want: "1.2.3",
+		},
+		{
+			name: "generated Info.plist",
+			buildSettings: serialized.Object{
+				"MARKETING_VERSION": "1.2.3",
+			},
+			want: "1.2.3",
+		},
+		{
+			name: "no version",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			infoPlist := unmarshalInformationPropertyList(t, tt.infoPlist)
+
+			got, err := marketingVersion(infoPlist, tt.buildSettings)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+const appleGenericVersioningInfoPlist = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>CFBundleShortVersionString</key>
+	<string>$(MARKETING_VERSION)</string>
+	<key>CFBundleVersion</key>
+	<string>$(CURRENT_PROJECT_VERSION)</string>
+</dict>
+</plist>
+`
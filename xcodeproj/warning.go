@@ -0,0 +1,19 @@
+package xcodeproj
+
+import (
+	"fmt"
+)
+
+// Warning is a finding of a project audit, pointing to the target's build setting in the given configuration.
+type Warning struct {
+	Target        string
+	Configuration string
+	BuildSetting  string
+	Value         string
+	Message       string
+}
+
+// String ...
+func (w Warning) String() string {
+	return fmt.Sprintf("%s (%s): %s = %s: %s", w.Target, w.Configuration, w.BuildSetting, w.Value, w.Message)
+}
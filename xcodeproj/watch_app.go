@@ -0,0 +1,140 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+const watchKitExtensionProductType = "com.apple.product-type.watchkit2-extension"
+
+// WatchCompanionBundleID returns the bundle id of the iOS app the watch app belongs to:
+// the WKCompanionAppBundleIdentifier key of the watch app target's Info.plist
+// (or the INFOPLIST_KEY_WKCompanionAppBundleIdentifier build setting of a generated Info.plist).
+// An empty bundle id is returned if the key is not set.
+func (p XcodeProj) WatchCompanionBundleID(watchTargetName, configuration string) (string, error) {
+	buildSettings, infoPlist, err := p.targetBuildSettingsAndInformationPropertyList(watchTargetName, configuration)
+	if err != nil {
+		return "", err
+	}
+
+	bundleID, _, err := informationPropertyListString(infoPlist, buildSettings, "WKCompanionAppBundleIdentifier")
+	return bundleID, err
+}
+
+// WatchExtensionAppBundleID returns the bundle id of the watch app the watch app's WatchKit extension belongs to:
+// the WKAppBundleIdentifier key of the extension's Info.plist NSExtension.NSExtensionAttributes dictionary.
+// The returned bool reports whether the watch app has a WatchKit extension, single target watch apps have none.
+// An empty bundle id is returned if the key is not set.
+func (p XcodeProj) WatchExtensionAppBundleID(watchTargetName, configuration string) (string, bool, error) {
+	extension, ok, err := p.watchKitExtension(watchTargetName)
+	if err != nil || !ok {
+		return "", false, err
+	}
+
+	buildSettings, infoPlist, err := p.targetBuildSettingsAndInformationPropertyList(extension.Name, configuration)
+	if err != nil {
+		return "", false, err
+	}
+
+	extensionAttributes, err := infoPlist.Object("NSExtension")
+	if err == nil {
+		extensionAttributes, err = extensionAttributes.Object("NSExtensionAttributes")
+	}
+	if err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return "", true, nil
+		}
+		return "", false, err
+	}
+
+	bundleID, err := optionalString(extensionAttributes, "WKAppBundleIdentifier")
+	if err != nil {
+		return "", false, err
+	}
+	if strings.Contains(bundleID, "$") {
+		if bundleID, err = Resolve(bundleID, buildSettings); err != nil {
+			return "", false, err
+		}
+	}
+	return bundleID, true, nil
+}
+
+// WatchCompanionBundleIDMatches reports whether the watch app's bundle ids are consistent:
+// its companion bundle id (see WatchCompanionBundleID) is the bundle id of its parent iOS app,
+// the app target depending on the watch app target, and its WatchKit extension's app bundle id
+// (see WatchExtensionAppBundleID) is the watch app's bundle id.
+// A mismatch makes the watch app fail to install with the iOS app.
+func (p XcodeProj) WatchCompanionBundleIDMatches(watchTargetName, configuration string) (bool, error) {
+	parent, err := p.watchParentApp(watchTargetName)
+	if err != nil {
+		return false, err
+	}
+
+	companionBundleID, err := p.WatchCompanionBundleID(watchTargetName, configuration)
+	if err != nil {
+		return false, err
+	}
+
+	parentBundleID, err := p.TargetBundleID(parent.Name, configuration)
+	if err != nil {
+		return false, fmt.Errorf("failed to read the bundle id of the parent app (%s): %s", parent.Name, err)
+	}
+
+	if companionBundleID != parentBundleID {
+		return false, nil
+	}
+
+	appBundleID, hasExtension, err := p.WatchExtensionAppBundleID(watchTargetName, configuration)
+	if err != nil {
+		return false, fmt.Errorf("failed to read the app bundle id of the WatchKit extension: %s", err)
+	} else if !hasExtension {
+		return true, nil
+	}
+
+	watchBundleID, err := p.TargetBundleID(watchTargetName, configuration)
+	if err != nil {
+		return false, err
+	}
+
+	return appBundleID == watchBundleID, nil
+}
+
+// watchParentApp returns the app target directly depending on the watch app target, which embeds the watch app.
+func (p XcodeProj) watchParentApp(watchTargetName string) (Target, error) {
+	watchTarget, ok := p.Proj.TargetByName(watchTargetName)
+	if !ok {
+		return Target{}, fmt.Errorf("target not found: %s", watchTargetName)
+	}
+
+	for _, target := range p.Proj.Targets {
+		if target.ID == watchTarget.ID || !target.IsAppProduct() {
+			continue
+		}
+
+		for _, dependency := range target.Dependencies {
+			if dependency.Target.ID == watchTarget.ID {
+				return target, nil
+			}
+		}
+	}
+
+	return Target{}, fmt.Errorf("no parent app found for watch app: %s", watchTargetName)
+}
+
+// watchKitExtension returns the WatchKit extension target the watch app target depends on,
+// the returned bool reports whether the watch app has a WatchKit extension.
+func (p XcodeProj) watchKitExtension(watchTargetName string) (Target, bool, error) {
+	watchTarget, ok := p.Proj.TargetByName(watchTargetName)
+	if !ok {
+		return Target{}, false, fmt.Errorf("target not found: %s", watchTargetName)
+	}
+
+	for _, dependency := range watchTarget.Dependencies {
+		if dependency.Target.ProductType == watchKitExtensionProductType {
+			return dependency.Target, true, nil
+		}
+	}
+	return Target{}, false, nil
+}
@@ -0,0 +1,287 @@
+package xcodeproj
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_WatchCompanionBundleID(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithWatchApp))
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(*project))
+
+	bundleID, err := project.WatchCompanionBundleID("Watch", "Debug")
+	require.NoError(t, err)
+	require.Equal(t, "io.bitrise.App", bundleID)
+
+	matches, err := project.WatchCompanionBundleIDMatches("Watch", "Debug")
+	require.NoError(t, err)
+	require.True(t, matches)
+
+	matches, err = project.WatchCompanionBundleIDMatches("Watch", "Release")
+	require.NoError(t, err)
+	require.False(t, matches)
+
+	_, err = project.WatchCompanionBundleIDMatches("Kit", "Debug")
+	require.Error(t, err)
+}
+
+func TestXcodeProj_WatchExtensionAppBundleID(t *testing.T) {
+	tests := []struct {
+		name        string
+		appBundleID string
+		wantMatches bool
+	}{
+		{name: "matching watch app bundle id", appBundleID: "$(PARENT_BUNDLE_IDENTIFIER).watchkitapp", wantMatches: true},
+		{name: "mismatching watch app bundle id", appBundleID: "io.bitrise.App.watch", wantMatches: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithWatchKitExtension, map[string]string{
+				"../WatchExtension/Info.plist": strings.Replace(watchKitExtensionInfoPlist, "WATCH_APP_BUNDLE_ID", tt.appBundleID, 1),
+			})
+			project, err := Open(projectPth)
+			require.NoError(t, err)
+			project.SetBuildSettingsProvider(rawBuildSettingsProvider(project))
+
+			appBundleID, hasExtension, err := project.WatchExtensionAppBundleID("Watch", "Debug")
+			require.NoError(t, err)
+			require.True(t, hasExtension)
+			require.Equal(t, strings.Replace(tt.appBundleID, "$(PARENT_BUNDLE_IDENTIFIER)", "io.bitrise.App", 1), appBundleID)
+
+			matches, err := project.WatchCompanionBundleIDMatches("Watch", "Debug")
+			require.NoError(t, err)
+			require.Equal(t, tt.wantMatches, matches)
+		})
+	}
+
+	project, err := parsePBXProjContent([]byte(pbxprojWithWatchApp))
+	require.NoError(t, err)
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(*project))
+
+	_, hasExtension, err := project.WatchExtensionAppBundleID("Watch", "Debug")
+	require.NoError(t, err)
+	require.False(t, hasExtension)
+}
+
+// pbxprojWithWatchApp extends pbxprojWithBuildFiles with a single target Watch app embedded in the App target,
+// its Release configuration's companion bundle id does not match the App's bundle id.
+var pbxprojWithWatchApp = newFixtureReplacer(
+	`/* Begin PBXCopyFilesBuildPhase section */`,
+	`/* Begin PBXContainerItemProxy section */
+		E2B0F0A32C8B4A0000A1B2C3 /* PBXContainerItemProxy */ = {
+			isa = PBXContainerItemProxy;
+			containerPortal = E2B0F0502C8B4A0000A1B2C3 /* Project object */;
+			proxyType = 1;
+			remoteGlobalIDString = E2B0F0452C8B4A0000A1B2C3;
+			remoteInfo = Watch;
+		};
+/* End PBXContainerItemProxy section */
+
+/* Begin PBXCopyFilesBuildPhase section */`,
+
+	`/* End PBXFileReference section */`,
+	`		E2B0F0102C8B4A0000A1B2C3 /* Watch.app */ = {isa = PBXFileReference; explicitFileType = wrapper.application; includeInIndex = 0; path = Watch.app; sourceTree = BUILT_PRODUCTS_DIR; };
+/* End PBXFileReference section */`,
+
+	`			dependencies = (
+			);
+			name = App;`,
+	`			dependencies = (
+				E2B0F0802C8B4A0000A1B2C3 /* PBXTargetDependency */,
+			);
+			name = App;`,
+
+	`/* End PBXNativeTarget section */`,
+	`		E2B0F0452C8B4A0000A1B2C3 /* Watch */ = {
+			isa = PBXNativeTarget;
+			buildConfigurationList = E2B0F0662C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "Watch" */;
+			buildPhases = (
+			);
+			buildRules = (
+			);
+			dependencies = (
+			);
+			name = Watch;
+			productName = Watch;
+			productReference = E2B0F0102C8B4A0000A1B2C3 /* Watch.app */;
+			productType = "com.apple.product-type.application";
+		};
+/* End PBXNativeTarget section */`,
+
+	`				E2B0F0412C8B4A0000A1B2C3 /* Kit */,
+			);`,
+	`				E2B0F0412C8B4A0000A1B2C3 /* Kit */,
+				E2B0F0452C8B4A0000A1B2C3 /* Watch */,
+			);`,
+
+	`/* End XCBuildConfiguration section */`,
+	`		E2B0F07C2C8B4A0000A1B2C3 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				GENERATE_INFOPLIST_FILE = YES;
+				INFOPLIST_KEY_WKCompanionAppBundleIdentifier = io.bitrise.App;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.App.watchkitapp;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				SDKROOT = watchos;
+			};
+			name = Debug;
+		};
+		E2B0F07D2C8B4A0000A1B2C3 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				GENERATE_INFOPLIST_FILE = YES;
+				INFOPLIST_KEY_WKCompanionAppBundleIdentifier = io.bitrise.Application;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.App.watchkitapp;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				SDKROOT = watchos;
+			};
+			name = Release;
+		};
+/* End XCBuildConfiguration section */`,
+
+	`/* End XCConfigurationList section */`,
+	`		E2B0F0662C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "Watch" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				E2B0F07C2C8B4A0000A1B2C3 /* Debug */,
+				E2B0F07D2C8B4A0000A1B2C3 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+/* End XCConfigurationList section */`,
+
+	`/* Begin XCBuildConfiguration section */`,
+	`/* Begin PBXTargetDependency section */
+		E2B0F0802C8B4A0000A1B2C3 /* PBXTargetDependency */ = {
+			isa = PBXTargetDependency;
+			target = E2B0F0452C8B4A0000A1B2C3 /* Watch */;
+			targetProxy = E2B0F0A32C8B4A0000A1B2C3 /* PBXContainerItemProxy */;
+		};
+/* End PBXTargetDependency section */
+
+/* Begin XCBuildConfiguration section */`,
+).Replace(pbxprojWithBuildFiles)
+
+// pbxprojWithWatchKitExtension extends pbxprojWithWatchApp with a WatchKit extension target (WatchExtension)
+// the Watch app depends on, its Info.plist is WatchExtension/Info.plist.
+var pbxprojWithWatchKitExtension = newFixtureReplacer(
+	`/* End PBXContainerItemProxy section */`,
+	`		E2B0F0E02C8B4A0000A1B2C3 /* PBXContainerItemProxy */ = {
+			isa = PBXContainerItemProxy;
+			containerPortal = E2B0F0502C8B4A0000A1B2C3 /* Project object */;
+			proxyType = 1;
+			remoteGlobalIDString = E2B0F0DA2C8B4A0000A1B2C3;
+			remoteInfo = WatchExtension;
+		};
+/* End PBXContainerItemProxy section */`,
+
+	`/* End PBXFileReference section */`,
+	`		E2B0F0DB2C8B4A0000A1B2C3 /* WatchExtension.appex */ = {isa = PBXFileReference; explicitFileType = "wrapper.app-extension"; includeInIndex = 0; path = WatchExtension.appex; sourceTree = BUILT_PRODUCTS_DIR; };
+/* End PBXFileReference section */`,
+
+	`			dependencies = (
+			);
+			name = Watch;`,
+	`			dependencies = (
+				E2B0F0DF2C8B4A0000A1B2C3 /* PBXTargetDependency */,
+			);
+			name = Watch;`,
+
+	`			productType = "com.apple.product-type.application";
+		};
+/* End PBXNativeTarget section */`,
+	`			productType = "com.apple.product-type.application.watchapp2";
+		};
+		E2B0F0DA2C8B4A0000A1B2C3 /* WatchExtension */ = {
+			isa = PBXNativeTarget;
+			buildConfigurationList = E2B0F0DC2C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "WatchExtension" */;
+			buildPhases = (
+			);
+			buildRules = (
+			);
+			dependencies = (
+			);
+			name = WatchExtension;
+			productName = WatchExtension;
+			productReference = E2B0F0DB2C8B4A0000A1B2C3 /* WatchExtension.appex */;
+			productType = "com.apple.product-type.watchkit2-extension";
+		};
+/* End PBXNativeTarget section */`,
+
+	`				E2B0F0452C8B4A0000A1B2C3 /* Watch */,
+			);`,
+	`				E2B0F0452C8B4A0000A1B2C3 /* Watch */,
+				E2B0F0DA2C8B4A0000A1B2C3 /* WatchExtension */,
+			);`,
+
+	`/* End PBXTargetDependency section */`,
+	`		E2B0F0DF2C8B4A0000A1B2C3 /* PBXTargetDependency */ = {
+			isa = PBXTargetDependency;
+			target = E2B0F0DA2C8B4A0000A1B2C3 /* WatchExtension */;
+			targetProxy = E2B0F0E02C8B4A0000A1B2C3 /* PBXContainerItemProxy */;
+		};
+/* End PBXTargetDependency section */`,
+
+	`/* End XCBuildConfiguration section */`,
+	`		E2B0F0DD2C8B4A0000A1B2C3 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				INFOPLIST_FILE = WatchExtension/Info.plist;
+				PARENT_BUNDLE_IDENTIFIER = io.bitrise.App;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.App.watchkitapp.watchkitextension;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				SDKROOT = watchos;
+			};
+			name = Debug;
+		};
+		E2B0F0DE2C8B4A0000A1B2C3 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				INFOPLIST_FILE = WatchExtension/Info.plist;
+				PARENT_BUNDLE_IDENTIFIER = io.bitrise.App;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.App.watchkitapp.watchkitextension;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				SDKROOT = watchos;
+			};
+			name = Release;
+		};
+/* End XCBuildConfiguration section */`,
+
+	`/* End XCConfigurationList section */`,
+	`		E2B0F0DC2C8B4A0000A1B2C3 /* Build configuration list for PBXNativeTarget "WatchExtension" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				E2B0F0DD2C8B4A0000A1B2C3 /* Debug */,
+				E2B0F0DE2C8B4A0000A1B2C3 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+/* End XCConfigurationList section */`,
+).Replace(pbxprojWithWatchApp)
+
+// watchKitExtensionInfoPlist is the Info.plist of a WatchKit extension,
+// WATCH_APP_BUNDLE_ID is a placeholder for its WKAppBundleIdentifier.
+const watchKitExtensionInfoPlist = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>CFBundleIdentifier</key>
+	<string>$(PRODUCT_BUNDLE_IDENTIFIER)</string>
+	<key>NSExtension</key>
+	<dict>
+		<key>NSExtensionAttributes</key>
+		<dict>
+			<key>WKAppBundleIdentifier</key>
+			<string>WATCH_APP_BUNDLE_ID</string>
+		</dict>
+		<key>NSExtensionPointIdentifier</key>
+		<string>com.apple.watchkit</string>
+	</dict>
+</dict>
+</plist>
+`
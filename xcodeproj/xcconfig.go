@@ -0,0 +1,157 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/bitrise-io/xcode-project/serialized"
+)
+
+// TargetConfigurationXCConfig returns the absolute path of the xcconfig file the target's build configuration is based on
+// (its baseConfigurationReference), like the Pods-App.release.xcconfig of CocoaPods.
+// An empty path is returned if the configuration is not based on an xcconfig file.
+func (p XcodeProj) TargetConfigurationXCConfig(target, configuration string) (string, error) {
+	if configuration == "" {
+		return "", fmt.Errorf("no configuration provided for target: %s", target)
+	}
+
+	buildConfigurations, err := p.targetBuildConfigurations(target, configuration)
+	if err != nil {
+		return "", err
+	}
+
+	return p.baseConfigurationPath(buildConfigurations[0])
+}
+
+// ProjectConfigurationXCConfig returns the absolute path of the xcconfig file the project's build configuration is based on.
+// An empty path is returned if the configuration is not based on an xcconfig file.
+func (p XcodeProj) ProjectConfigurationXCConfig(configuration string) (string, error) {
+	for _, buildConfiguration := range p.Proj.BuildConfigurationList.BuildConfigurations {
+		if buildConfiguration.Name == configuration {
+			return p.baseConfigurationPath(buildConfiguration)
+		}
+	}
+	return "", fmt.Errorf("configuration (%s) not found for project", configuration)
+}
+
+// baseConfigurationPath returns the absolute path of the build configuration's baseConfigurationReference file,
+// or an empty path if the build configuration has no base configuration.
+func (p XcodeProj) baseConfigurationPath(buildConfiguration BuildConfiguration) (string, error) {
+	objects, err := p.RawProj.Object("objects")
+	if err != nil {
+		return "", err
+	}
+
+	rawBuildConfiguration, err := objects.Object(buildConfiguration.ID)
+	if err != nil {
+		return "", err
+	}
+
+	baseConfigurationReference, err := optionalString(rawBuildConfiguration, "baseConfigurationReference")
+	if err != nil || baseConfigurationReference == "" {
+		return "", err
+	}
+
+	pth, err := p.fileReferenceAbsolutePath(baseConfigurationReference, objects)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve the xcconfig file of configuration (%s): %s", buildConfiguration.Name, err)
+	}
+	return pth, nil
+}
+
+// SetTargetConfigurationXCConfig bases the target's build configuration on the xcconfig file (sets its baseConfigurationReference),
+// or all of the target's build configurations if the configuration is empty.
+// A relative xcconfigPath is relative to the directory of the project.
+// The file reference pointing to the xcconfig file is reused if the project has one,
+// otherwise a new file reference is added to the project's main group.
+// The project needs to be saved to persist the change.
+func (p XcodeProj) SetTargetConfigurationXCConfig(target, configuration, xcconfigPath string) error {
+	if err := p.checkOnDisk(); err != nil {
+		return err
+	}
+
+	buildConfigurations, err := p.targetBuildConfigurations(target, configuration)
+	if err != nil {
+		return err
+	}
+
+	objects, err := p.RawProj.Object("objects")
+	if err != nil {
+		return err
+	}
+
+	if !filepath.IsAbs(xcconfigPath) {
+		xcconfigPath = filepath.Join(filepath.Dir(p.Path), xcconfigPath)
+	}
+	xcconfigPath = filepath.Clean(xcconfigPath)
+
+	fileReferenceID, err := p.xcconfigFileReference(xcconfigPath, objects)
+	if err != nil {
+		return err
+	}
+
+	for _, buildConfiguration := range buildConfigurations {
+		rawBuildConfiguration, err := objects.Object(buildConfiguration.ID)
+		if err != nil {
+			return err
+		}
+		rawBuildConfiguration["baseConfigurationReference"] = fileReferenceID
+	}
+	return nil
+}
+
+// xcconfigFileReference returns the id of the file reference pointing to the xcconfig file,
+// adding a new, SOURCE_ROOT relative file reference to the project's main group if there is none.
+func (p XcodeProj) xcconfigFileReference(xcconfigPath string, objects serialized.Object) (string, error) {
+	for _, id := range sortedKeys(objects) {
+		object, err := objects.Object(id)
+		if err != nil {
+			return "", err
+		}
+
+		if ok, err := isFileReference(object); err != nil {
+			return "", err
+		} else if !ok {
+			continue
+		}
+
+		// file references in unsupported locations (like BUILT_PRODUCTS_DIR) can not be the xcconfig file
+		if pth, err := p.fileReferenceAbsolutePath(id, objects); err == nil && pth == xcconfigPath {
+			return id, nil
+		}
+	}
+
+	project, err := objects.Object(p.Proj.ID)
+	if err != nil {
+		return "", err
+	}
+
+	mainGroupID, err := project.String("mainGroup")
+	if err != nil {
+		return "", err
+	}
+
+	relativePath, err := filepath.Rel(filepath.Dir(p.Path), xcconfigPath)
+	if err != nil {
+		return "", err
+	}
+
+	id, err := newObjectID(objects)
+	if err != nil {
+		return "", err
+	}
+
+	objects[id] = map[string]interface{}{
+		"isa":               fileReferenceElementType,
+		"lastKnownFileType": "text.xcconfig",
+		"name":              filepath.Base(xcconfigPath),
+		"path":              relativePath,
+		"sourceTree":        "SOURCE_ROOT",
+	}
+
+	if err := appendGroupChild(mainGroupID, id, objects); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
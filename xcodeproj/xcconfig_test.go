@@ -0,0 +1,134 @@
+package xcodeproj
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcodeProj_TargetConfigurationXCConfig(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithXCConfigs))
+	require.NoError(t, err)
+	project.Path = "/Users/bitrise/App/App.xcodeproj"
+
+	tests := []struct {
+		name          string
+		target        string
+		configuration string
+		want          string
+		wantErr       bool
+	}{
+		{name: "debug", target: "App", configuration: "Debug", want: "/Users/bitrise/App/Pods/Target Support Files/Pods-App/Pods-App.debug.xcconfig"},
+		{name: "release", target: "App", configuration: "Release", want: "/Users/bitrise/App/Pods/Target Support Files/Pods-App/Pods-App.release.xcconfig"},
+		{name: "no xcconfig", target: "Kit", configuration: "Release", want: ""},
+		{name: "missing configuration", target: "App", configuration: "Staging", wantErr: true},
+		{name: "no configuration", target: "App", configuration: "", wantErr: true},
+		{name: "missing target", target: "Missing", configuration: "Debug", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := project.TargetConfigurationXCConfig(tt.target, tt.configuration)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestXcodeProj_ProjectConfigurationXCConfig(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithXCConfigs))
+	require.NoError(t, err)
+	project.Path = "/Users/bitrise/App/App.xcodeproj"
+
+	pth, err := project.ProjectConfigurationXCConfig("Release")
+	require.NoError(t, err)
+	require.Equal(t, "/Users/bitrise/App/Config/Shared.xcconfig", pth)
+
+	pth, err = project.ProjectConfigurationXCConfig("Debug")
+	require.NoError(t, err)
+	require.Equal(t, "", pth)
+
+	_, err = project.ProjectConfigurationXCConfig("Staging")
+	require.Error(t, err)
+}
+
+func TestXcodeProj_SetTargetConfigurationXCConfig(t *testing.T) {
+	projectPth := createTmpProject(t, "App.xcodeproj", pbxprojWithXCConfigs, nil)
+	project, err := Open(projectPth)
+	require.NoError(t, err)
+	projectDir := filepath.Dir(projectPth)
+
+	// reuses the existing file reference
+	require.NoError(t, project.SetTargetConfigurationXCConfig("Kit", "Release", "Pods/Target Support Files/Pods-App/Pods-App.release.xcconfig"))
+	// adds a new file reference
+	require.NoError(t, project.SetTargetConfigurationXCConfig("Kit", "Debug", filepath.Join(projectDir, "Config", "Kit.xcconfig")))
+	require.NoError(t, project.Save())
+
+	project, err = Open(projectPth)
+	require.NoError(t, err)
+
+	pth, err := project.TargetConfigurationXCConfig("Kit", "Release")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(projectDir, "Pods/Target Support Files/Pods-App/Pods-App.release.xcconfig"), pth)
+
+	pth, err = project.TargetConfigurationXCConfig("Kit", "Debug")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(projectDir, "Config", "Kit.xcconfig"), pth)
+
+	pth, err = project.TargetConfigurationXCConfig("App", "Debug")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(projectDir, "Pods/Target Support Files/Pods-App/Pods-App.debug.xcconfig"), pth)
+
+	require.Error(t, project.SetTargetConfigurationXCConfig("Kit", "Staging", "Config/Kit.xcconfig"))
+}
+
+// pbxprojWithXCConfigs extends pbxprojWithBuildFiles with CocoaPods generated xcconfig files
+// as the base configurations of the App target, and a SOURCE_ROOT relative xcconfig file for the project's Release configuration.
+var pbxprojWithXCConfigs = newFixtureReplacer(
+	`/* End PBXFileReference section */`,
+	`		E2B0F00B2C8B4A0000A1B2C3 /* Pods-App.debug.xcconfig */ = {isa = PBXFileReference; includeInIndex = 1; lastKnownFileType = text.xcconfig; name = "Pods-App.debug.xcconfig"; path = "Target Support Files/Pods-App/Pods-App.debug.xcconfig"; sourceTree = "<group>"; };
+		E2B0F00C2C8B4A0000A1B2C3 /* Pods-App.release.xcconfig */ = {isa = PBXFileReference; includeInIndex = 1; lastKnownFileType = text.xcconfig; name = "Pods-App.release.xcconfig"; path = "Target Support Files/Pods-App/Pods-App.release.xcconfig"; sourceTree = "<group>"; };
+		E2B0F00D2C8B4A0000A1B2C3 /* Shared.xcconfig */ = {isa = PBXFileReference; lastKnownFileType = text.xcconfig; path = Config/Shared.xcconfig; sourceTree = SOURCE_ROOT; };
+/* End PBXFileReference section */`,
+
+	`				E2B0F0332C8B4A0000A1B2C3 /* Products */,
+			);
+			sourceTree = "<group>";
+		};`,
+	`				E2B0F0332C8B4A0000A1B2C3 /* Products */,
+				E2B0F0342C8B4A0000A1B2C3 /* Pods */,
+			);
+			sourceTree = "<group>";
+		};
+		E2B0F0342C8B4A0000A1B2C3 /* Pods */ = {
+			isa = PBXGroup;
+			children = (
+				E2B0F00B2C8B4A0000A1B2C3 /* Pods-App.debug.xcconfig */,
+				E2B0F00C2C8B4A0000A1B2C3 /* Pods-App.release.xcconfig */,
+			);
+			path = Pods;
+			sourceTree = "<group>";
+		};`,
+
+	`		E2B0F0712C8B4A0000A1B2C3 /* Release */ = {
+			isa = XCBuildConfiguration;`,
+	`		E2B0F0712C8B4A0000A1B2C3 /* Release */ = {
+			isa = XCBuildConfiguration;
+			baseConfigurationReference = E2B0F00D2C8B4A0000A1B2C3 /* Shared.xcconfig */;`,
+
+	`		E2B0F0722C8B4A0000A1B2C3 /* Debug */ = {
+			isa = XCBuildConfiguration;`,
+	`		E2B0F0722C8B4A0000A1B2C3 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			baseConfigurationReference = E2B0F00B2C8B4A0000A1B2C3 /* Pods-App.debug.xcconfig */;`,
+
+	`		E2B0F0732C8B4A0000A1B2C3 /* Release */ = {
+			isa = XCBuildConfiguration;`,
+	`		E2B0F0732C8B4A0000A1B2C3 /* Release */ = {
+			isa = XCBuildConfiguration;
+			baseConfigurationReference = E2B0F00C2C8B4A0000A1B2C3 /* Pods-App.release.xcconfig */;`,
+).Replace(pbxprojWithBuildFiles)
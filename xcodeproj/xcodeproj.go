@@ -3,6 +3,7 @@ package xcodeproj
 import (
 	"errors"
 	"fmt"
+	"io/fs"
 	"io/ioutil"
 	"path"
 	"path/filepath"
@@ -31,6 +32,12 @@ type XcodeProj struct {
 	// It allows better compatibility with Cordova and the Xcode agvtool
 	originalContents                  []byte
 	originalPbxProj, annotatedPbxProj serialized.Object
+	// The object id -> name annotations (`/* Name */` comments) of the original project.pbxproj
+	objectAnnotations map[string]string
+	// Used instead of xcodebuild to provide the target build settings, if set
+	buildSettingsProvider BuildSettingsProvider
+	// Set if the project is opened by OpenFS, its Path is not a path on disk
+	fsBacked bool
 
 	Name string
 	Path string
@@ -42,6 +49,14 @@ func (p XcodeProj) buildSettingsFilePath(target, configuration, key string) (str
 		return "", err
 	}
 
+	return p.buildSettingsPath(buildSettings, key)
+}
+
+func (p XcodeProj) buildSettingsPath(buildSettings serialized.Object, key string) (string, error) {
+	if err := p.checkOnDisk(); err != nil {
+		return "", err
+	}
+
 	pth, err := buildSettings.String(key)
 	if err != nil {
 		return "", err
@@ -71,14 +86,7 @@ func (p XcodeProj) ForceTargetCodeSignEntitlement(target, configuration, entitle
 		return err
 	}
 
-	codeSignEntitlements, format, err := ReadPlistFile(codeSignEntitlementsPth)
-	if err != nil {
-		return err
-	}
-
-	codeSignEntitlements[entitlement] = value
-
-	return WritePlistFile(codeSignEntitlementsPth, codeSignEntitlements, format)
+	return updatePlistFile(codeSignEntitlementsPth, serialized.Object{entitlement: value})
 }
 
 // TargetCodeSignEntitlements ...
@@ -155,16 +163,36 @@ func (p XcodeProj) TargetBundleID(target, configuration string) (string, error)
 		return "", err
 	}
 
+	return p.bundleID(buildSettings)
+}
+
+func (p XcodeProj) bundleID(buildSettings serialized.Object) (string, error) {
+	bundleID, err := p.unresolvedBundleID(buildSettings)
+	if err != nil {
+		return "", err
+	}
+
+	return Resolve(bundleID, buildSettings)
+}
+
+// unresolvedBundleID returns the PRODUCT_BUNDLE_IDENTIFIER build setting or the Info.plist's CFBundleIdentifier,
+// without resolving the build setting references.
+func (p XcodeProj) unresolvedBundleID(buildSettings serialized.Object) (string, error) {
 	bundleID, err := buildSettings.String("PRODUCT_BUNDLE_IDENTIFIER")
 	if err != nil && !serialized.IsKeyNotFoundError(err) {
 		return "", err
 	}
 
 	if bundleID != "" {
-		return Resolve(bundleID, buildSettings)
+		return bundleID, nil
 	}
 
-	informationPropertyList, err := p.TargetInformationPropertyList(target, configuration)
+	pth, err := p.buildSettingsPath(buildSettings, "INFOPLIST_FILE")
+	if err != nil {
+		return "", err
+	}
+
+	informationPropertyList, _, err := ReadPlistFile(pth)
 	if err != nil {
 		return "", err
 	}
@@ -178,7 +206,7 @@ func (p XcodeProj) TargetBundleID(target, configuration string) (string, error)
 		return "", errors.New("no PRODUCT_BUNDLE_IDENTIFIER build settings nor CFBundleIdentifier information property found")
 	}
 
-	return Resolve(bundleID, buildSettings)
+	return bundleID, nil
 }
 
 // Resolve returns the resolved bundleID. We need this, because the bundleID is not exposed in the .pbxproj file ( raw ).
@@ -276,11 +304,154 @@ func envInBuildSettings(envKey string, buildSettings serialized.Object) (string,
 	return envValue, true
 }
 
-// TargetBuildSettings ...
+var (
+	// buildSettingReferenceRegexp matches the $(KEY), ${KEY}, $(KEY:modifier) and ${KEY:modifier} build setting references.
+	buildSettingReferenceRegexp = regexp.MustCompile(`\$[({]([A-Za-z0-9_]+)(:[^)}]*)?[)}]`)
+
+	rfc1034IdentifierInvalidCharacters = regexp.MustCompile(`[^A-Za-z0-9.-]`)
+	c99IdentifierInvalidCharacters     = regexp.MustCompile(`[^A-Za-z0-9_]`)
+)
+
+// maxResolveDepth limits the nested reference resolution, to stop on reference cycles.
+const maxResolveDepth = 16
+
+// resolveReferences resolves the $(KEY) and ${KEY} build setting references in the value recursively,
+// applying the references' modifiers (like $(PRODUCT_NAME:rfc1034identifier)).
+// References not found in the build settings are left unchanged if keepUnknown is set, otherwise an error is returned.
+// Unlike Resolve, the references of the $KEY form are not resolved.
+func resolveReferences(value string, buildSettings serialized.Object, keepUnknown bool) (string, error) {
+	return resolveReferencesAtDepth(value, buildSettings, keepUnknown, 0)
+}
+
+func resolveReferencesAtDepth(value string, buildSettings serialized.Object, keepUnknown bool, depth int) (string, error) {
+	if depth > maxResolveDepth {
+		return "", fmt.Errorf("build setting reference cycle found in: %s", value)
+	}
+
+	var resolveErr error
+	resolved := buildSettingReferenceRegexp.ReplaceAllStringFunc(value, func(reference string) string {
+		if resolveErr != nil {
+			return reference
+		}
+
+		match := buildSettingReferenceRegexp.FindStringSubmatch(reference)
+		setting, ok := envInBuildSettings(match[1], buildSettings)
+		if !ok {
+			if !keepUnknown {
+				resolveErr = fmt.Errorf("failed to find env in build settings: %s", match[1])
+			}
+			return reference
+		}
+
+		setting, resolveErr = resolveReferencesAtDepth(setting, buildSettings, keepUnknown, depth+1)
+		if resolveErr != nil {
+			return reference
+		}
+
+		for _, modifier := range strings.Split(strings.TrimPrefix(match[2], ":"), ":") {
+			setting = applyBuildSettingModifier(setting, modifier)
+		}
+		return setting
+	})
+	if resolveErr != nil {
+		return "", resolveErr
+	}
+	return resolved, nil
+}
+
+// resolveKnownReferences resolves the build setting references found in the build settings (see resolveReferences),
+// leaving the unknown ones (like $(AppIdentifierPrefix)) unchanged. The value is returned unchanged on reference cycles.
+func resolveKnownReferences(value string, buildSettings serialized.Object) string {
+	resolved, err := resolveReferences(value, buildSettings, true)
+	if err != nil {
+		return value
+	}
+	return resolved
+}
+
+// applyBuildSettingModifier applies the build setting reference modifier (like rfc1034identifier) to the value.
+// Unknown modifiers leave the value unchanged.
+func applyBuildSettingModifier(value, modifier string) string {
+	switch modifier {
+	case "rfc1034identifier":
+		return rfc1034IdentifierInvalidCharacters.ReplaceAllString(value, "-")
+	case "c99extidentifier", "identifier":
+		identifier := c99IdentifierInvalidCharacters.ReplaceAllString(value, "_")
+		if identifier != "" && identifier[0] >= '0' && identifier[0] <= '9' {
+			identifier = "_" + identifier
+		}
+		return identifier
+	case "lower":
+		return strings.ToLower(value)
+	case "upper":
+		return strings.ToUpper(value)
+	default:
+		return value
+	}
+}
+
+// BuildSettingsProvider returns the build settings of the target for the given configuration.
+type BuildSettingsProvider func(target, configuration string) (serialized.Object, error)
+
+// SetBuildSettingsProvider sets the provider used by TargetBuildSettings instead of running xcodebuild,
+// for example to read the build settings from a previously captured cache.
+// Passing nil restores the xcodebuild based resolution.
+func (p *XcodeProj) SetBuildSettingsProvider(provider BuildSettingsProvider) {
+	p.buildSettingsProvider = provider
+}
+
+// TargetBuildSettings returns the target's build settings for the given configuration.
+// The build settings are read by running xcodebuild, unless a provider is set by SetBuildSettingsProvider,
+// in which case the customOptions are ignored.
 func (p XcodeProj) TargetBuildSettings(target, configuration string, customOptions ...string) (serialized.Object, error) {
+	if p.buildSettingsProvider != nil {
+		return p.buildSettingsProvider(target, configuration)
+	}
+	if err := p.checkOnDisk(); err != nil {
+		return nil, err
+	}
 	return xcodebuild.ShowProjectBuildSettings(p.Path, target, configuration, customOptions...)
 }
 
+// TargetBuildSettingsWithOverrides returns the target's build settings with the overrides applied,
+// the way the SETTING=value arguments of the xcodebuild command override the project's build settings.
+// The overrides are passed to xcodebuild, so the build settings depending on them (like the bundle id) reflect them.
+// If a provider is set by SetBuildSettingsProvider, the overrides replace the provided build settings,
+// and the references to them are resolved by the readers (like Resolve).
+func (p XcodeProj) TargetBuildSettingsWithOverrides(target, configuration string, overrides map[string]string) (serialized.Object, error) {
+	keys := make([]string, 0, len(overrides))
+	for key := range overrides {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	if p.buildSettingsProvider == nil {
+		if err := p.checkOnDisk(); err != nil {
+			return nil, err
+		}
+
+		var customOptions []string
+		for _, key := range keys {
+			customOptions = append(customOptions, key+"="+overrides[key])
+		}
+		return xcodebuild.ShowProjectBuildSettings(p.Path, target, configuration, customOptions...)
+	}
+
+	buildSettings, err := p.buildSettingsProvider(target, configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	overridden := serialized.Object{}
+	for key, value := range buildSettings {
+		overridden[key] = value
+	}
+	for _, key := range keys {
+		overridden[key] = overrides[key]
+	}
+	return overridden, nil
+}
+
 // Scheme returns the project's scheme by name and the project's absolute path.
 func (p XcodeProj) Scheme(name string) (*xcscheme.Scheme, string, error) {
 	schemes, err := p.Schemes()
@@ -300,9 +471,20 @@ func (p XcodeProj) Scheme(name string) (*xcscheme.Scheme, string, error) {
 
 // Schemes ...
 func (p XcodeProj) Schemes() ([]xcscheme.Scheme, error) {
+	if err := p.checkOnDisk(); err != nil {
+		return nil, err
+	}
 	return xcscheme.FindSchemesIn(p.Path)
 }
 
+// HasSharedSchemes reports whether the project has any shared scheme.
+func (p XcodeProj) HasSharedSchemes() (bool, error) {
+	if err := p.checkOnDisk(); err != nil {
+		return false, err
+	}
+	return xcscheme.HasSharedSchemesIn(p.Path)
+}
+
 // Open ...
 func Open(pth string) (XcodeProj, error) {
 	absPth, err := pathutil.AbsPath(pth)
@@ -328,6 +510,36 @@ func Open(pth string) (XcodeProj, error) {
 	return *p, nil
 }
 
+// OpenFS opens the project at projectDir (like App/App.xcodeproj) in the file system,
+// like the fs.FS of an uploaded zip archive, without extracting it to disk.
+// The project's Path is the slash-separated projectDir within fsys. Only the project.pbxproj is read,
+// the methods reading or writing further files (like schemes, Info.plist or xcconfig files) and Save return an error.
+func OpenFS(fsys fs.FS, projectDir string) (XcodeProj, error) {
+	content, err := fs.ReadFile(fsys, path.Join(projectDir, "project.pbxproj"))
+	if err != nil {
+		return XcodeProj{}, err
+	}
+
+	p, err := parsePBXProjContent(content)
+	if err != nil {
+		return XcodeProj{}, err
+	}
+
+	p.Path = projectDir
+	p.Name = strings.TrimSuffix(path.Base(projectDir), path.Ext(projectDir))
+	p.fsBacked = true
+
+	return *p, nil
+}
+
+// checkOnDisk returns an error if the project is opened by OpenFS, so the files next to it can not be read or written.
+func (p XcodeProj) checkOnDisk() error {
+	if p.fsBacked {
+		return fmt.Errorf("project (%s) is opened from a file system, its files are not available on disk", p.Path)
+	}
+	return nil
+}
+
 func parsePBXProjContent(content []byte) (*XcodeProj, error) {
 	var rawPbxProj serialized.Object
 	format, err := plist.UnmarshalWithCustomAnnotation(content, &rawPbxProj)
@@ -374,12 +586,13 @@ func parsePBXProjContent(content []byte) (*XcodeProj, error) {
 	}
 
 	return &XcodeProj{
-		Proj:             proj,
-		RawProj:          rawPbxProj,
-		Format:           format,
-		originalPbxProj:  originalPbxProj,
-		annotatedPbxProj: annotatedPbxProj,
-		originalContents: content,
+		Proj:              proj,
+		RawProj:           rawPbxProj,
+		Format:            format,
+		originalPbxProj:   originalPbxProj,
+		annotatedPbxProj:  annotatedPbxProj,
+		originalContents:  content,
+		objectAnnotations: parseObjectAnnotations(content),
 	}, nil
 }
 
@@ -501,6 +714,10 @@ func (p XcodeProj) Save() error {
 
 // savePBXProj overrides the project.pbxproj file of  the XcodeProj with the contents of `rawProj`
 func (p XcodeProj) savePBXProj() error {
+	if err := p.checkOnDisk(); err != nil {
+		return err
+	}
+
 	pth := path.Join(p.Path, "project.pbxproj")
 	newContent, merr := p.perObjectModify()
 	if merr == nil {
@@ -3,7 +3,9 @@ package xcodeproj
 import (
 	"path/filepath"
 	"testing"
+	"testing/fstest"
 
+	"github.com/bitrise-io/go-utils/pathutil"
 	"github.com/bitrise-io/xcode-project/serialized"
 	"github.com/bitrise-io/xcode-project/testhelper"
 	"github.com/bitrise-io/xcode-project/xcscheme"
@@ -379,6 +381,18 @@ func TestExpandSimpleEnv(t *testing.T) {
 	}
 }
 
+func Test_resolveKnownReferences(t *testing.T) {
+	buildSettings := serialized.Object{
+		"A":            "$(B)",
+		"B":            "$(A)",
+		"C":            "c",
+		"PRODUCT_NAME": "My App",
+	}
+	require.Equal(t, "c.$(UNKNOWN)", resolveKnownReferences("$(C).$(UNKNOWN)", buildSettings))
+	require.Equal(t, "io.bitrise.My-App", resolveKnownReferences("io.bitrise.${PRODUCT_NAME:rfc1034identifier}", buildSettings))
+	require.Equal(t, "$(A)", resolveKnownReferences("$(A)", buildSettings))
+}
+
 func TestTargets(t *testing.T) {
 	dir := testhelper.GitCloneIntoTmpDir(t, "https://github.com/bitrise-io/xcode-project-test.git")
 	project, err := Open(filepath.Join(dir, "Group/SubProject/SubProject.xcodeproj"))
@@ -505,6 +519,46 @@ func TestSchemes(t *testing.T) {
 	require.Equal(t, "ProjectScheme", schemes[1].Name)
 }
 
+func TestOpenFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"App/App.xcodeproj/project.pbxproj": &fstest.MapFile{Data: []byte(pbxprojWithXCConfigs)},
+	}
+
+	project, err := OpenFS(fsys, "App/App.xcodeproj")
+	require.NoError(t, err)
+	require.Equal(t, "App", project.Name)
+	require.Equal(t, "App/App.xcodeproj", project.Path)
+
+	target, ok := project.Proj.TargetByName("Kit")
+	require.True(t, ok)
+	require.Equal(t, "E2B0F0412C8B4A0000A1B2C3", target.ID)
+
+	_, err = project.Schemes()
+	require.Error(t, err)
+	require.Error(t, project.Save())
+
+	project.SetBuildSettingsProvider(rawBuildSettingsProvider(project))
+	_, err = project.TargetInformationPropertyListPath("App", "Debug")
+	require.Error(t, err)
+	_, err = project.TargetConfigurationXCConfig("App", "Debug")
+	require.Error(t, err)
+	_, err = project.TargetBuildDirs("App", "Debug")
+	require.Error(t, err)
+	_, err = project.TargetHeaderSearchPaths("App", "Debug")
+	require.Error(t, err)
+	_, err = project.GroupForPath("/App/Sources")
+	require.Error(t, err)
+
+	_, err = project.RecreateSharedSchemes()
+	require.Error(t, err)
+	exist, err := pathutil.IsPathExists(filepath.Join("App/App.xcodeproj", "xcshareddata"))
+	require.NoError(t, err)
+	require.False(t, exist)
+
+	_, err = OpenFS(fsys, "Missing.xcodeproj")
+	require.Error(t, err)
+}
+
 func TestOpenXcodeproj(t *testing.T) {
 	t.Log("Opening Pods.xcodeproj in sample-apps-ios-workspace-swift.git")
 	{
@@ -529,6 +583,51 @@ func TestIsXcodeProj(t *testing.T) {
 	require.False(t, IsXcodeProj("./BitriseSample.xcworkspace"))
 }
 
+func TestXcodeProj_SetBuildSettingsProvider(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithouthTargetAttributes))
+	require.NoError(t, err)
+
+	var requested []string
+	project.SetBuildSettingsProvider(func(target, configuration string) (serialized.Object, error) {
+		requested = append(requested, target+"/"+configuration)
+		return serialized.Object{
+			"PRODUCT_BUNDLE_IDENTIFIER": "io.bitrise.$(PRODUCT_NAME)",
+			"PRODUCT_NAME":              target,
+		}, nil
+	})
+
+	bundleID, err := project.TargetBundleID("Target", "Debug")
+	require.NoError(t, err)
+	require.Equal(t, "io.bitrise.Target", bundleID)
+	require.Equal(t, []string{"Target/Debug"}, requested)
+}
+
+func TestXcodeProj_TargetBuildSettingsWithOverrides(t *testing.T) {
+	project, err := parsePBXProjContent([]byte(pbxprojWithouthTargetAttributes))
+	require.NoError(t, err)
+
+	provided := serialized.Object{
+		"PRODUCT_BUNDLE_IDENTIFIER": "io.bitrise.$(PRODUCT_NAME)",
+		"PRODUCT_NAME":              "Target",
+	}
+	project.SetBuildSettingsProvider(func(target, configuration string) (serialized.Object, error) {
+		return provided, nil
+	})
+
+	buildSettings, err := project.TargetBuildSettingsWithOverrides("Target", "Debug", map[string]string{"PRODUCT_NAME": "Override"})
+	require.NoError(t, err)
+	bundleID, err := project.bundleID(buildSettings)
+	require.NoError(t, err)
+	require.Equal(t, "io.bitrise.Override", bundleID)
+	require.Equal(t, "Target", provided["PRODUCT_NAME"])
+
+	buildSettings, err = project.TargetBuildSettingsWithOverrides("Target", "Debug", map[string]string{"PRODUCT_BUNDLE_IDENTIFIER": "io.bitrise.ci"})
+	require.NoError(t, err)
+	bundleID, err = project.bundleID(buildSettings)
+	require.NoError(t, err)
+	require.Equal(t, "io.bitrise.ci", bundleID)
+}
+
 func TestXcodeProj_forceBundleID(t *testing.T) {
 	dir := testhelper.GitCloneIntoTmpDir(t, "https://github.com/bitrise-io/xcode-project-test.git")
 	project, err := Open(filepath.Join(dir, "XcodeProj.xcodeproj"))
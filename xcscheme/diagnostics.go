@@ -0,0 +1,18 @@
+package xcscheme
+
+// Diagnostics represents the runtime diagnostics options of a scheme action.
+type Diagnostics struct {
+	AddressSanitizer           bool
+	ThreadSanitizer            bool
+	UndefinedBehaviorSanitizer bool
+	MainThreadCheckerDisabled  bool
+}
+
+func newDiagnostics(addressSanitizer, threadSanitizer, ubSanitizer, disableMainThreadChecker string) Diagnostics {
+	return Diagnostics{
+		AddressSanitizer:           addressSanitizer == "YES",
+		ThreadSanitizer:            threadSanitizer == "YES",
+		UndefinedBehaviorSanitizer: ubSanitizer == "YES",
+		MainThreadCheckerDisabled:  disableMainThreadChecker == "YES",
+	}
+}
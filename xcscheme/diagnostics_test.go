@@ -0,0 +1,60 @@
+package xcscheme
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheme_Diagnostics(t *testing.T) {
+	var scheme Scheme
+	require.NoError(t, xml.Unmarshal([]byte(schemeWithDiagnosticsContent), &scheme))
+
+	require.Equal(t, Diagnostics{
+		AddressSanitizer:           true,
+		UndefinedBehaviorSanitizer: true,
+		MainThreadCheckerDisabled:  true,
+	}, scheme.Diagnostics())
+
+	require.Equal(t, Diagnostics{
+		ThreadSanitizer: true,
+	}, scheme.TestAction.Diagnostics())
+}
+
+func TestScheme_Diagnostics_Defaults(t *testing.T) {
+	var scheme Scheme
+	require.NoError(t, xml.Unmarshal([]byte(schemeContent), &scheme))
+
+	require.Equal(t, Diagnostics{}, scheme.Diagnostics())
+	require.Equal(t, Diagnostics{}, scheme.TestAction.Diagnostics())
+}
+
+const schemeWithDiagnosticsContent = `<?xml version="1.0" encoding="UTF-8"?>
+<Scheme
+   LastUpgradeVersion = "1500"
+   version = "1.7">
+   <TestAction
+      buildConfiguration = "Debug"
+      selectedDebuggerIdentifier = "Xcode.DebuggerFoundation.Debugger.LLDB"
+      selectedLauncherIdentifier = "Xcode.DebuggerFoundation.Launcher.LLDB"
+      enableThreadSanitizer = "YES"
+      shouldUseLaunchSchemeArgsEnv = "NO">
+   </TestAction>
+   <LaunchAction
+      buildConfiguration = "Debug"
+      selectedDebuggerIdentifier = "Xcode.DebuggerFoundation.Debugger.LLDB"
+      selectedLauncherIdentifier = "Xcode.DebuggerFoundation.Launcher.LLDB"
+      enableAddressSanitizer = "YES"
+      enableASanStackUseAfterReturn = "YES"
+      enableUBSanitizer = "YES"
+      disableMainThreadChecker = "YES"
+      launchStyle = "0"
+      useCustomWorkingDirectory = "NO"
+      ignoresPersistentStateOnLaunch = "NO"
+      debugDocumentVersioning = "YES"
+      debugServiceExtension = "internal"
+      allowLocationSimulation = "YES">
+   </LaunchAction>
+</Scheme>
+`
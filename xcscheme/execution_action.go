@@ -0,0 +1,160 @@
+package xcscheme
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+)
+
+// ShellScriptActionType is the ActionType of the scheme pre- and post-actions running a shell script.
+const ShellScriptActionType = "Xcode.IDEStandardExecutionActionsCore.ExecutionActionType.ShellScriptAction"
+
+// ExecutionAction is a pre- or post-action of a scheme action, like a script run before the build.
+type ExecutionAction struct {
+	ActionType    string `xml:"ActionType,attr"`
+	ActionContent ActionContent
+}
+
+// ActionContent ...
+type ActionContent struct {
+	Title         string `xml:"title,attr"`
+	ScriptText    string `xml:"scriptText,attr"`
+	ShellToInvoke string `xml:"shellToInvoke,attr"`
+	// EnvironmentBuildable is the target whose build settings are provided to the script as environment variables,
+	// empty if the script runs without build settings.
+	EnvironmentBuildable BuildableReference `xml:"EnvironmentBuildable>BuildableReference"`
+}
+
+// IsShellScript reports whether the action runs a shell script (as opposed to sending an email).
+func (a ExecutionAction) IsShellScript() bool {
+	return a.ActionType == ShellScriptActionType
+}
+
+// PreActions returns the pre-actions of the given scheme action (build, test, launch or archive),
+// run before the action in the order of the scheme.
+func (s Scheme) PreActions(action string) ([]ExecutionAction, error) {
+	preActions, _, err := s.executionActions(action)
+	return preActions, err
+}
+
+// PostActions returns the post-actions of the given scheme action (build, test, launch or archive),
+// run after the action in the order of the scheme.
+func (s Scheme) PostActions(action string) ([]ExecutionAction, error) {
+	_, postActions, err := s.executionActions(action)
+	return postActions, err
+}
+
+func (s Scheme) executionActions(action string) ([]ExecutionAction, []ExecutionAction, error) {
+	switch action {
+	case BuildActionName:
+		return s.BuildAction.PreActions, s.BuildAction.PostActions, nil
+	case TestActionName:
+		return s.TestAction.PreActions, s.TestAction.PostActions, nil
+	case LaunchActionName:
+		return s.LaunchAction.PreActions, s.LaunchAction.PostActions, nil
+	case ArchiveActionName:
+		return s.ArchiveAction.PreActions, s.ArchiveAction.PostActions, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported scheme action: %s", action)
+	}
+}
+
+// RemovePreActions removes the pre-actions of the given scheme action (build, test, launch or archive)
+// and writes the scheme file. The rest of the scheme file is left unchanged.
+func (s *Scheme) RemovePreActions(action string) error {
+	return s.removeExecutionActions(action, "PreActions")
+}
+
+// RemovePostActions removes the post-actions of the given scheme action (build, test, launch or archive)
+// and writes the scheme file. The rest of the scheme file is left unchanged.
+func (s *Scheme) RemovePostActions(action string) error {
+	return s.removeExecutionActions(action, "PostActions")
+}
+
+func (s *Scheme) removeExecutionActions(action, element string) error {
+	var actionElement string
+	var preActions, postActions *[]ExecutionAction
+	switch action {
+	case BuildActionName:
+		actionElement, preActions, postActions = "BuildAction", &s.BuildAction.PreActions, &s.BuildAction.PostActions
+	case TestActionName:
+		actionElement, preActions, postActions = "TestAction", &s.TestAction.PreActions, &s.TestAction.PostActions
+	case LaunchActionName:
+		actionElement, preActions, postActions = "LaunchAction", &s.LaunchAction.PreActions, &s.LaunchAction.PostActions
+	case ArchiveActionName:
+		actionElement, preActions, postActions = "ArchiveAction", &s.ArchiveAction.PreActions, &s.ArchiveAction.PostActions
+	default:
+		return fmt.Errorf("unsupported scheme action: %s", action)
+	}
+
+	content, err := fileutil.ReadBytesFromFile(s.Path)
+	if err != nil {
+		return err
+	}
+
+	content, err = removeChildElement(content, actionElement, element)
+	if err != nil {
+		return fmt.Errorf("failed to remove %s %s: %s", action, element, err)
+	}
+
+	if err := ioutil.WriteFile(s.Path, content, 0644); err != nil {
+		return err
+	}
+
+	if element == "PreActions" {
+		*preActions = nil
+	} else {
+		*postActions = nil
+	}
+	return nil
+}
+
+// removeChildElement removes the child element (with its whole line if it starts the line)
+// from the first element with the given parent name in the raw scheme content.
+// The content is returned unchanged if the parent has no such child.
+func removeChildElement(content []byte, parent, child string) ([]byte, error) {
+	parentStartTag := regexp.MustCompile(`<` + regexp.QuoteMeta(parent) + `(\s[^>]*)?/?>`)
+	loc := parentStartTag.FindIndex(content)
+	if loc == nil {
+		return nil, fmt.Errorf("element not found: %s", parent)
+	}
+	if bytes.HasSuffix(content[loc[0]:loc[1]], []byte("/>")) {
+		return content, nil
+	}
+
+	parentEnd := bytes.Index(content[loc[1]:], []byte("</"+parent+">"))
+	if parentEnd == -1 {
+		return nil, fmt.Errorf("element not closed: %s", parent)
+	}
+	parentContent := content[loc[1] : loc[1]+parentEnd]
+
+	childStartTag := regexp.MustCompile(`<` + regexp.QuoteMeta(child) + `(\s[^>]*)?/?>`)
+	childLoc := childStartTag.FindIndex(parentContent)
+	if childLoc == nil {
+		return content, nil
+	}
+
+	start, end := loc[1]+childLoc[0], loc[1]+childLoc[1]
+	if !bytes.HasSuffix(content[start:end], []byte("/>")) {
+		childEnd := bytes.Index(content[end:loc[1]+parentEnd], []byte("</"+child+">"))
+		if childEnd == -1 {
+			return nil, fmt.Errorf("element not closed: %s", child)
+		}
+		end += childEnd + len("</"+child+">")
+	}
+
+	if indent := elementIndentation(content, start); indent != "" || start == 0 || content[start-1] == '\n' {
+		start -= len(indent)
+		if end < len(content) && content[end] == '\n' {
+			end++
+		}
+	}
+
+	var modified []byte
+	modified = append(modified, content[:start]...)
+	modified = append(modified, content[end:]...)
+	return modified, nil
+}
@@ -0,0 +1,112 @@
+package xcscheme
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+	"github.com/bitrise-io/xcode-project/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheme_PreActions(t *testing.T) {
+	var scheme Scheme
+	require.NoError(t, xml.Unmarshal([]byte(schemeWithPreBuildScriptContent), &scheme))
+
+	preActions, err := scheme.PreActions(BuildActionName)
+	require.NoError(t, err)
+	require.Equal(t, []ExecutionAction{
+		{
+			ActionType: ShellScriptActionType,
+			ActionContent: ActionContent{
+				Title:         "Generate Secrets",
+				ScriptText:    "cd \"${SRCROOT}\"\n./scripts/generate-secrets.sh\n",
+				ShellToInvoke: "/bin/bash",
+				EnvironmentBuildable: BuildableReference{
+					BlueprintIdentifier: "BA3CBE7419F7A93800CED4D5",
+					BlueprintName:       "ios-simple-objc",
+					BuildableName:       "ios-simple-objc.app",
+					ReferencedContainer: "container:ios-simple-objc.xcodeproj",
+				},
+			},
+		},
+	}, preActions)
+	require.True(t, preActions[0].IsShellScript())
+
+	postActions, err := scheme.PostActions(TestActionName)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(postActions))
+	require.Equal(t, "Notify", postActions[0].ActionContent.Title)
+	require.False(t, postActions[0].IsShellScript())
+
+	postActions, err = scheme.PostActions(BuildActionName)
+	require.NoError(t, err)
+	require.Equal(t, []ExecutionAction(nil), postActions)
+
+	_, err = scheme.PreActions(ProfileActionName)
+	require.Error(t, err)
+}
+
+// schemeWithPreBuildScriptContent runs a script with the app's build settings before the build,
+// and sends an email after the tests.
+var schemeWithPreBuildScriptContent = strings.NewReplacer(
+	`      buildImplicitDependencies = "YES">
+      <BuildActionEntries>`,
+	`      buildImplicitDependencies = "YES">
+      <PreActions>
+         <ExecutionAction
+            ActionType = "Xcode.IDEStandardExecutionActionsCore.ExecutionActionType.ShellScriptAction">
+            <ActionContent
+               title = "Generate Secrets"
+               scriptText = "cd &quot;${SRCROOT}&quot;&#10;./scripts/generate-secrets.sh&#10;"
+               shellToInvoke = "/bin/bash">
+               <EnvironmentBuildable>
+                  <BuildableReference
+                     BuildableIdentifier = "primary"
+                     BlueprintIdentifier = "BA3CBE7419F7A93800CED4D5"
+                     BuildableName = "ios-simple-objc.app"
+                     BlueprintName = "ios-simple-objc"
+                     ReferencedContainer = "container:ios-simple-objc.xcodeproj">
+                  </BuildableReference>
+               </EnvironmentBuildable>
+            </ActionContent>
+         </ExecutionAction>
+      </PreActions>
+      <BuildActionEntries>`,
+	`      shouldUseLaunchSchemeArgsEnv = "YES">
+      <Testables>`,
+	`      shouldUseLaunchSchemeArgsEnv = "YES">
+      <PostActions>
+         <ExecutionAction
+            ActionType = "Xcode.IDEStandardExecutionActionsCore.ExecutionActionType.SendEmailAction">
+            <ActionContent
+               title = "Notify"
+               emailRecipient = "ci@example.com"
+               emailSubject = "Tests finished"
+               attachLogToEmail = "NO">
+            </ActionContent>
+         </ExecutionAction>
+      </PostActions>
+      <Testables>`,
+).Replace(schemeContent)
+
+func TestScheme_RemovePreActions(t *testing.T) {
+	pth := testhelper.CreateTmpFile(t, "App.xcscheme", schemeWithPreBuildScriptContent)
+	scheme, err := Open(pth)
+	require.NoError(t, err)
+
+	require.NoError(t, scheme.RemovePreActions(BuildActionName))
+	require.NoError(t, scheme.RemovePostActions(TestActionName))
+	// no post-actions to remove
+	require.NoError(t, scheme.RemovePostActions(LaunchActionName))
+	require.Error(t, scheme.RemovePreActions(ProfileActionName))
+
+	preActions, err := scheme.PreActions(BuildActionName)
+	require.NoError(t, err)
+	require.Equal(t, []ExecutionAction(nil), preActions)
+
+	content, err := fileutil.ReadStringFromFile(pth)
+	require.NoError(t, err)
+	require.Equal(t, schemeContent, content)
+}
@@ -0,0 +1,88 @@
+package xcscheme
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+)
+
+// Test repetition modes of the scheme's test action
+const (
+	TestRepetitionNone            = "None"
+	TestRepetitionUntilFailure    = "UntilFailure"
+	TestRepetitionRetryOnFailure  = "RetryOnFailure"
+	TestRepetitionFixedIterations = "FixedIterations"
+)
+
+// TestRepetition represents the test repetition options of the scheme's test action.
+// Iterations is the maximum number of test repetitions, 0 if not set.
+type TestRepetition struct {
+	Mode       string
+	Iterations int
+}
+
+// TestRepetition returns the test repetition options of the scheme's test action.
+// Besides the testRepetitionMode and maximumTestRepetitions attributes, the legacy runTestsUntilFailure
+// and numberOfTestExecutions attributes are considered.
+func (s Scheme) TestRepetition() (TestRepetition, error) {
+	repetition := TestRepetition{Mode: s.TestAction.TestRepetitionMode}
+	if repetition.Mode == "" {
+		repetition.Mode = TestRepetitionNone
+		if s.TestAction.RunTestsUntilFailure == "YES" {
+			repetition.Mode = TestRepetitionUntilFailure
+		}
+	}
+
+	iterations := s.TestAction.MaximumTestRepetitions
+	if iterations == "" {
+		iterations = s.TestAction.NumberOfTestExecutions
+	}
+	if iterations != "" {
+		var err error
+		if repetition.Iterations, err = strconv.Atoi(iterations); err != nil {
+			return TestRepetition{}, fmt.Errorf("invalid test repetition count: %s", iterations)
+		}
+	}
+
+	return repetition, nil
+}
+
+// SetTestRepetition sets the test repetition options of the scheme's test action and writes the scheme file.
+// Only the testRepetitionMode and maximumTestRepetitions attributes are modified, the rest of the scheme file is left unchanged.
+// Like Xcode, the maximumTestRepetitions attribute is omitted if the mode is TestRepetitionNone.
+func (s *Scheme) SetTestRepetition(repetition TestRepetition) error {
+	switch repetition.Mode {
+	case TestRepetitionNone, TestRepetitionUntilFailure, TestRepetitionRetryOnFailure, TestRepetitionFixedIterations:
+	default:
+		return fmt.Errorf("unknown test repetition mode: %s", repetition.Mode)
+	}
+
+	content, err := fileutil.ReadBytesFromFile(s.Path)
+	if err != nil {
+		return err
+	}
+
+	iterations := ""
+	if repetition.Mode == TestRepetitionNone {
+		content, err = removeElementAttribute(content, "TestAction", "maximumTestRepetitions")
+	} else {
+		iterations = strconv.Itoa(repetition.Iterations)
+		content, err = setElementAttribute(content, "TestAction", "maximumTestRepetitions", iterations)
+	}
+	if err == nil {
+		content, err = setElementAttribute(content, "TestAction", "testRepetitionMode", repetition.Mode)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set test repetition: %s", err)
+	}
+
+	if err := ioutil.WriteFile(s.Path, content, 0644); err != nil {
+		return err
+	}
+
+	s.TestAction.TestRepetitionMode = repetition.Mode
+	s.TestAction.MaximumTestRepetitions = iterations
+	return nil
+}
@@ -0,0 +1,103 @@
+package xcscheme
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+	"github.com/bitrise-io/xcode-project/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheme_TestRepetition(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    TestRepetition
+	}{
+		{
+			name:    "until failure",
+			content: schemeWithTestRepetitionContent,
+			want:    TestRepetition{Mode: TestRepetitionUntilFailure, Iterations: 100},
+		},
+		{
+			name:    "legacy run tests until failure",
+			content: schemeWithLegacyTestRepetitionContent,
+			want:    TestRepetition{Mode: TestRepetitionUntilFailure, Iterations: 50},
+		},
+		{
+			name:    "no repetition",
+			content: schemeContent,
+			want:    TestRepetition{Mode: TestRepetitionNone},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var scheme Scheme
+			require.NoError(t, xml.Unmarshal([]byte(tt.content), &scheme))
+
+			got, err := scheme.TestRepetition()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestScheme_SetTestRepetition(t *testing.T) {
+	pth := testhelper.CreateTmpFile(t, "App.xcscheme", schemeWithTestRepetitionContent)
+	scheme, err := Open(pth)
+	require.NoError(t, err)
+
+	require.NoError(t, scheme.SetTestRepetition(TestRepetition{Mode: TestRepetitionRetryOnFailure, Iterations: 3}))
+	require.Error(t, scheme.SetTestRepetition(TestRepetition{Mode: "Forever"}))
+
+	scheme, err = Open(pth)
+	require.NoError(t, err)
+
+	repetition, err := scheme.TestRepetition()
+	require.NoError(t, err)
+	require.Equal(t, TestRepetition{Mode: TestRepetitionRetryOnFailure, Iterations: 3}, repetition)
+	require.Equal(t, "Debug", scheme.TestAction.BuildConfiguration)
+
+	require.NoError(t, scheme.SetTestRepetition(TestRepetition{Mode: TestRepetitionNone}))
+	content, err := fileutil.ReadStringFromFile(pth)
+	require.NoError(t, err)
+	require.NotContains(t, content, "maximumTestRepetitions")
+	require.Contains(t, content, `      shouldUseLaunchSchemeArgsEnv = "YES"
+      testRepetitionMode = "None">`)
+
+	scheme, err = Open(pth)
+	require.NoError(t, err)
+
+	repetition, err = scheme.TestRepetition()
+	require.NoError(t, err)
+	require.Equal(t, TestRepetition{Mode: TestRepetitionNone}, repetition)
+}
+
+const schemeWithTestRepetitionContent = `<?xml version="1.0" encoding="UTF-8"?>
+<Scheme
+   LastUpgradeVersion = "1500"
+   version = "1.7">
+   <TestAction
+      buildConfiguration = "Debug"
+      selectedDebuggerIdentifier = "Xcode.DebuggerFoundation.Debugger.LLDB"
+      selectedLauncherIdentifier = "Xcode.DebuggerFoundation.Launcher.LLDB"
+      shouldUseLaunchSchemeArgsEnv = "YES"
+      testRepetitionMode = "UntilFailure"
+      maximumTestRepetitions = "100">
+   </TestAction>
+</Scheme>
+`
+
+const schemeWithLegacyTestRepetitionContent = `<?xml version="1.0" encoding="UTF-8"?>
+<Scheme
+   LastUpgradeVersion = "1130"
+   version = "1.3">
+   <TestAction
+      buildConfiguration = "Debug"
+      shouldUseLaunchSchemeArgsEnv = "YES"
+      runTestsUntilFailure = "YES"
+      numberOfTestExecutions = "50">
+   </TestAction>
+</Scheme>
+`
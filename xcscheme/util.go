@@ -30,6 +30,16 @@ func FindSchemesIn(root string) (schemes []Scheme, err error) {
 	return
 }
 
+// HasSharedSchemesIn reports whether the project or workspace at root has any shared scheme,
+// without parsing the scheme files.
+func HasSharedSchemesIn(root string) (bool, error) {
+	sharedPths, err := pathsByPattern(root, "xcshareddata", "xcschemes", "*.xcscheme")
+	if err != nil {
+		return false, err
+	}
+	return len(sharedPths) > 0, nil
+}
+
 func pathsByPattern(paths ...string) ([]string, error) {
 	pattern := filepath.Join(paths...)
 	return filepath.Glob(pattern)
@@ -0,0 +1,259 @@
+package xcscheme
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+)
+
+// Scheme action names
+const (
+	BuildActionName   = "build"
+	TestActionName    = "test"
+	LaunchActionName  = "launch"
+	ArchiveActionName = "archive"
+	AnalyzeActionName = "analyze"
+	ProfileActionName = "profile"
+)
+
+// actionConfigurationElementName returns the name of the scheme element holding the given action's build configuration.
+// The build action does not have a configuration on its own, xcodebuild builds with the launch action's configuration.
+func actionConfigurationElementName(action string) (string, error) {
+	switch action {
+	case BuildActionName, LaunchActionName:
+		return "LaunchAction", nil
+	case TestActionName:
+		return "TestAction", nil
+	case ArchiveActionName:
+		return "ArchiveAction", nil
+	case AnalyzeActionName:
+		return "AnalyzeAction", nil
+	case ProfileActionName:
+		return "ProfileAction", nil
+	default:
+		return "", fmt.Errorf("unknown scheme action: %s", action)
+	}
+}
+
+// SetBuildConfiguration sets the build configuration of the given scheme action (build, test, launch, archive, analyze or profile)
+// and writes the scheme file.
+// Only the action's buildConfiguration attribute is modified, the rest of the scheme file is left unchanged.
+func (s *Scheme) SetBuildConfiguration(action, configuration string) error {
+	element, err := actionConfigurationElementName(action)
+	if err != nil {
+		return err
+	}
+
+	content, err := fileutil.ReadBytesFromFile(s.Path)
+	if err != nil {
+		return err
+	}
+
+	content, err = setElementAttribute(content, element, "buildConfiguration", configuration)
+	if err != nil {
+		return fmt.Errorf("failed to set %s build configuration: %s", action, err)
+	}
+
+	if err := ioutil.WriteFile(s.Path, content, 0644); err != nil {
+		return err
+	}
+
+	switch element {
+	case "LaunchAction":
+		s.LaunchAction.BuildConfiguration = configuration
+	case "TestAction":
+		s.TestAction.BuildConfiguration = configuration
+	case "ArchiveAction":
+		s.ArchiveAction.BuildConfiguration = configuration
+	}
+
+	return nil
+}
+
+// setElementAttribute sets the attribute of the first element with the given name in the raw scheme content.
+// A missing attribute is added as the element's first attribute, following the Xcode scheme formatting.
+func setElementAttribute(content []byte, element, attribute, value string) ([]byte, error) {
+	startTag := regexp.MustCompile(`<` + regexp.QuoteMeta(element) + `(\s[^>]*)?/?>`)
+	loc := startTag.FindIndex(content)
+	if loc == nil {
+		return nil, fmt.Errorf("element not found: %s", element)
+	}
+
+	var escaped bytes.Buffer
+	if err := xml.EscapeText(&escaped, []byte(value)); err != nil {
+		return nil, err
+	}
+
+	tag := content[loc[0]:loc[1]]
+	attributePattern := regexp.MustCompile(`(\s)` + regexp.QuoteMeta(attribute) + `\s*=\s*"[^"]*"`)
+
+	var newTag []byte
+	if attributePattern.Match(tag) {
+		newTag = attributePattern.ReplaceAllFunc(tag, func(match []byte) []byte {
+			return []byte(string(match[0]) + attribute + ` = "` + escaped.String() + `"`)
+		})
+	} else {
+		nameEnd := len("<" + element)
+		indent := elementIndentation(content, loc[0]) + "   "
+		newTag = append([]byte{}, tag[:nameEnd]...)
+		newTag = append(newTag, []byte("\n"+indent+attribute+` = "`+escaped.String()+`"`)...)
+		newTag = append(newTag, tag[nameEnd:]...)
+	}
+
+	var modified []byte
+	modified = append(modified, content[:loc[0]]...)
+	modified = append(modified, newTag...)
+	modified = append(modified, content[loc[1]:]...)
+	return modified, nil
+}
+
+// removeElementAttribute removes the attribute (with the whitespace preceding it) from the first element with the given name
+// in the raw scheme content. The content is returned unchanged if the element has no such attribute.
+func removeElementAttribute(content []byte, element, attribute string) ([]byte, error) {
+	startTag := regexp.MustCompile(`<` + regexp.QuoteMeta(element) + `(\s[^>]*)?/?>`)
+	loc := startTag.FindIndex(content)
+	if loc == nil {
+		return nil, fmt.Errorf("element not found: %s", element)
+	}
+
+	attributePattern := regexp.MustCompile(`\s+` + regexp.QuoteMeta(attribute) + `\s*=\s*"[^"]*"`)
+	newTag := attributePattern.ReplaceAll(content[loc[0]:loc[1]], nil)
+
+	var modified []byte
+	modified = append(modified, content[:loc[0]]...)
+	modified = append(modified, newTag...)
+	modified = append(modified, content[loc[1]:]...)
+	return modified, nil
+}
+
+// elementIndentation returns the whitespace preceding the element at pos on its line.
+func elementIndentation(content []byte, pos int) string {
+	lineStart := bytes.LastIndexByte(content[:pos], '\n') + 1
+	line := string(content[lineStart:pos])
+	if strings.TrimSpace(line) != "" {
+		return ""
+	}
+	return line
+}
+
+// WriteDefaultScheme writes a minimal scheme to pth, similar to the one Xcode autocreates for an app target:
+// the app is built, launched and archived, the testables are built for testing and run by the test action.
+// The test and launch actions use the debug configuration, the archive action uses the release configuration.
+func WriteDefaultScheme(pth string, app BuildableReference, testables []BuildableReference, debugConfiguration, releaseConfiguration string) error {
+	var content bytes.Buffer
+	if err := defaultSchemeTemplate.Execute(&content, struct {
+		App                  BuildableReference
+		Testables            []BuildableReference
+		DebugConfiguration   string
+		ReleaseConfiguration string
+	}{
+		App:                  app,
+		Testables:            testables,
+		DebugConfiguration:   debugConfiguration,
+		ReleaseConfiguration: releaseConfiguration,
+	}); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(pth), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(pth, content.Bytes(), 0644)
+}
+
+func escapeXMLAttribute(value string) (string, error) {
+	var escaped bytes.Buffer
+	if err := xml.EscapeText(&escaped, []byte(value)); err != nil {
+		return "", err
+	}
+	return escaped.String(), nil
+}
+
+var defaultSchemeTemplate = template.Must(template.New("scheme").Funcs(template.FuncMap{"xml": escapeXMLAttribute}).Parse(`<?xml version="1.0" encoding="UTF-8"?>
+<Scheme
+   LastUpgradeVersion = "1500"
+   version = "1.7">
+   <BuildAction
+      parallelizeBuildables = "YES"
+      buildImplicitDependencies = "YES">
+      <BuildActionEntries>
+         <BuildActionEntry
+            buildForTesting = "YES"
+            buildForRunning = "YES"
+            buildForProfiling = "YES"
+            buildForArchiving = "YES"
+            buildForAnalyzing = "YES">
+{{template "reference" .App}}
+         </BuildActionEntry>
+      </BuildActionEntries>
+   </BuildAction>
+   <TestAction
+      buildConfiguration = "{{xml .DebugConfiguration}}"
+      selectedDebuggerIdentifier = "Xcode.DebuggerFoundation.Debugger.LLDB"
+      selectedLauncherIdentifier = "Xcode.DebuggerFoundation.Launcher.LLDB"
+      shouldUseLaunchSchemeArgsEnv = "YES">
+      <Testables>
+{{- range .Testables}}
+         <TestableReference
+            skipped = "NO">
+{{template "reference" .}}
+         </TestableReference>
+{{- end}}
+      </Testables>
+   </TestAction>
+   <LaunchAction
+      buildConfiguration = "{{xml .DebugConfiguration}}"
+      selectedDebuggerIdentifier = "Xcode.DebuggerFoundation.Debugger.LLDB"
+      selectedLauncherIdentifier = "Xcode.DebuggerFoundation.Launcher.LLDB"
+      launchStyle = "0"
+      useCustomWorkingDirectory = "NO"
+      ignoresPersistentStateOnLaunch = "NO"
+      debugDocumentVersioning = "YES"
+      debugServiceExtension = "internal"
+      allowLocationSimulation = "YES">
+      <BuildableProductRunnable
+         runnableDebuggingMode = "0">
+{{template "runnableReference" .App}}
+      </BuildableProductRunnable>
+   </LaunchAction>
+   <ProfileAction
+      buildConfiguration = "{{xml .ReleaseConfiguration}}"
+      shouldUseLaunchSchemeArgsEnv = "YES"
+      savedToolIdentifier = ""
+      useCustomWorkingDirectory = "NO"
+      debugDocumentVersioning = "YES">
+      <BuildableProductRunnable
+         runnableDebuggingMode = "0">
+{{template "runnableReference" .App}}
+      </BuildableProductRunnable>
+   </ProfileAction>
+   <AnalyzeAction
+      buildConfiguration = "{{xml .DebugConfiguration}}">
+   </AnalyzeAction>
+   <ArchiveAction
+      buildConfiguration = "{{xml .ReleaseConfiguration}}"
+      revealArchiveInOrganizer = "YES">
+   </ArchiveAction>
+</Scheme>
+{{define "reference"}}            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "{{xml .BlueprintIdentifier}}"
+               BuildableName = "{{xml .BuildableName}}"
+               BlueprintName = "{{xml .BlueprintName}}"
+               ReferencedContainer = "{{xml .ReferencedContainer}}">
+            </BuildableReference>{{end}}
+{{define "runnableReference"}}         <BuildableReference
+            BuildableIdentifier = "primary"
+            BlueprintIdentifier = "{{xml .BlueprintIdentifier}}"
+            BuildableName = "{{xml .BuildableName}}"
+            BlueprintName = "{{xml .BlueprintName}}"
+            ReferencedContainer = "{{xml .ReferencedContainer}}">
+         </BuildableReference>{{end}}`))
@@ -0,0 +1,134 @@
+package xcscheme
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+	"github.com/bitrise-io/xcode-project/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheme_SetBuildConfiguration(t *testing.T) {
+	pth := testhelper.CreateTmpFile(t, "ios-simple-objc.xcscheme", schemeContent)
+	scheme, err := Open(pth)
+	require.NoError(t, err)
+
+	require.NoError(t, scheme.SetBuildConfiguration(ArchiveActionName, "Staging"))
+	require.NoError(t, scheme.SetBuildConfiguration(TestActionName, "Release"))
+	require.Equal(t, "Staging", scheme.ArchiveAction.BuildConfiguration)
+
+	reopened, err := Open(pth)
+	require.NoError(t, err)
+	require.Equal(t, "Staging", reopened.ArchiveAction.BuildConfiguration)
+	require.Equal(t, "Release", reopened.TestAction.BuildConfiguration)
+	require.Equal(t, "Debug", reopened.LaunchAction.BuildConfiguration)
+
+	content, err := fileutil.ReadStringFromFile(pth)
+	require.NoError(t, err)
+	expected := strings.Replace(schemeContent, `<ArchiveAction
+      buildConfiguration = "Release"`, `<ArchiveAction
+      buildConfiguration = "Staging"`, 1)
+	expected = strings.Replace(expected, `<TestAction
+      buildConfiguration = "Debug"`, `<TestAction
+      buildConfiguration = "Release"`, 1)
+	require.Equal(t, expected, content)
+
+	require.Error(t, scheme.SetBuildConfiguration("deploy", "Release"))
+}
+
+func Test_setElementAttribute(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "replaces existing attribute",
+			content: `   <ArchiveAction
+      buildConfiguration = "Release"
+      revealArchiveInOrganizer = "YES">
+   </ArchiveAction>`,
+			want: `   <ArchiveAction
+      buildConfiguration = "Debug &amp; Test"
+      revealArchiveInOrganizer = "YES">
+   </ArchiveAction>`,
+		},
+		{
+			name: "adds missing attribute",
+			content: `   <ArchiveAction
+      revealArchiveInOrganizer = "YES">
+   </ArchiveAction>`,
+			want: `   <ArchiveAction
+      buildConfiguration = "Debug &amp; Test"
+      revealArchiveInOrganizer = "YES">
+   </ArchiveAction>`,
+		},
+		{
+			name:    "adds attribute to an element without attributes",
+			content: `   <ArchiveAction/>`,
+			want: `   <ArchiveAction
+      buildConfiguration = "Debug &amp; Test"/>`,
+		},
+		{
+			name:    "missing element",
+			content: `   <TestAction/>`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := setElementAttribute([]byte(tt.content), "ArchiveAction", "buildConfiguration", "Debug & Test")
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestWriteDefaultScheme(t *testing.T) {
+	pth := filepath.Join(t.TempDir(), "xcshareddata", "xcschemes", "App.xcscheme")
+	app := BuildableReference{
+		BlueprintIdentifier: "BA3CBE7419F7A93800CED4D5",
+		BlueprintName:       "App & Co",
+		BuildableName:       "App & Co.app",
+		ReferencedContainer: "container:App.xcodeproj",
+	}
+	testable := BuildableReference{
+		BlueprintIdentifier: "BA3CBE9019F7A93900CED4D5",
+		BlueprintName:       "AppTests",
+		BuildableName:       "AppTests.xctest",
+		ReferencedContainer: "container:App.xcodeproj",
+	}
+
+	require.NoError(t, WriteDefaultScheme(pth, app, []BuildableReference{testable}, "Debug", "Release"))
+
+	scheme, err := Open(pth)
+	require.NoError(t, err)
+	require.Equal(t, "App", scheme.Name)
+	require.Equal(t, "Debug", scheme.TestAction.BuildConfiguration)
+	require.Equal(t, "Debug", scheme.LaunchAction.BuildConfiguration)
+	require.Equal(t, "Release", scheme.ArchiveAction.BuildConfiguration)
+	require.Equal(t, []BuildableReference{app}, scheme.BuildablesFor(ArchiveActionName))
+	require.Equal(t, []BuildableReference{app}, scheme.BuildablesFor(TestActionName))
+	require.Equal(t, []TestableReference{{Skipped: "NO", BuildableReference: testable}}, scheme.TestAction.Testables)
+	require.Equal(t, app, scheme.LaunchAction.BuildableProductRunnable.BuildableReference)
+
+	content, err := fileutil.ReadStringFromFile(pth)
+	require.NoError(t, err)
+	require.Contains(t, content, `      <BuildableProductRunnable
+         runnableDebuggingMode = "0">
+         <BuildableReference
+            BuildableIdentifier = "primary"
+            BlueprintIdentifier = "BA3CBE7419F7A93800CED4D5"
+            BuildableName = "App &amp; Co.app"
+            BlueprintName = "App &amp; Co"
+            ReferencedContainer = "container:App.xcodeproj">
+         </BuildableReference>
+      </BuildableProductRunnable>`)
+}
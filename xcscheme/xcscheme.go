@@ -38,13 +38,38 @@ func (r BuildableReference) ReferencedContainerAbsPath(schemeContainerDir string
 // BuildActionEntry ...
 type BuildActionEntry struct {
 	BuildForTesting    string `xml:"buildForTesting,attr"`
+	BuildForRunning    string `xml:"buildForRunning,attr"`
+	BuildForProfiling  string `xml:"buildForProfiling,attr"`
 	BuildForArchiving  string `xml:"buildForArchiving,attr"`
+	BuildForAnalyzing  string `xml:"buildForAnalyzing,attr"`
 	BuildableReference BuildableReference
 }
 
+// BuildsFor reports whether the entry is built for the given scheme action (test, launch, profile, archive or analyze).
+// The build action itself builds the entries built for running.
+func (e BuildActionEntry) BuildsFor(action string) bool {
+	switch action {
+	case TestActionName:
+		return e.BuildForTesting == "YES"
+	case BuildActionName, LaunchActionName:
+		return e.BuildForRunning == "YES"
+	case ProfileActionName:
+		return e.BuildForProfiling == "YES"
+	case ArchiveActionName:
+		return e.BuildForArchiving == "YES"
+	case AnalyzeActionName:
+		return e.BuildForAnalyzing == "YES"
+	default:
+		return false
+	}
+}
+
 // BuildAction ...
 type BuildAction struct {
 	BuildActionEntries []BuildActionEntry `xml:"BuildActionEntries>BuildActionEntry"`
+
+	PreActions  []ExecutionAction `xml:"PreActions>ExecutionAction"`
+	PostActions []ExecutionAction `xml:"PostActions>ExecutionAction"`
 }
 
 // TestableReference ...
@@ -57,11 +82,95 @@ type TestableReference struct {
 type TestAction struct {
 	Testables          []TestableReference `xml:"Testables>TestableReference"`
 	BuildConfiguration string              `xml:"buildConfiguration,attr"`
+
+	EnableAddressSanitizer   string `xml:"enableAddressSanitizer,attr"`
+	EnableThreadSanitizer    string `xml:"enableThreadSanitizer,attr"`
+	EnableUBSanitizer        string `xml:"enableUBSanitizer,attr"`
+	DisableMainThreadChecker string `xml:"disableMainThreadChecker,attr"`
+
+	TestRepetitionMode     string `xml:"testRepetitionMode,attr"`
+	MaximumTestRepetitions string `xml:"maximumTestRepetitions,attr"`
+	NumberOfTestExecutions string `xml:"numberOfTestExecutions,attr"`
+	RunTestsUntilFailure   string `xml:"runTestsUntilFailure,attr"`
+
+	TestPlans []TestPlanReference `xml:"TestPlans>TestPlanReference"`
+
+	PreActions  []ExecutionAction `xml:"PreActions>ExecutionAction"`
+	PostActions []ExecutionAction `xml:"PostActions>ExecutionAction"`
+}
+
+// TestPlanReference is a test plan (.xctestplan file) of the test action.
+type TestPlanReference struct {
+	Reference string `xml:"reference,attr"`
+	Default   string `xml:"default,attr"`
+}
+
+// AbsPath returns the absolute path of the referenced test plan file.
+func (r TestPlanReference) AbsPath(schemeContainerDir string) (string, error) {
+	s := strings.Split(r.Reference, ":")
+	if len(s) != 2 {
+		return "", fmt.Errorf("unknown test plan reference (%s)", r.Reference)
+	}
+
+	return pathutil.AbsPath(filepath.Join(schemeContainerDir, s[1]))
+}
+
+// DefaultTestPlan returns the test plan run by default: the one marked as default, or the first one.
+// False is returned if the test action does not use test plans.
+func (a TestAction) DefaultTestPlan() (TestPlanReference, bool) {
+	for _, testPlan := range a.TestPlans {
+		if testPlan.Default == "YES" {
+			return testPlan, true
+		}
+	}
+	if len(a.TestPlans) > 0 {
+		return a.TestPlans[0], true
+	}
+	return TestPlanReference{}, false
+}
+
+// Diagnostics returns the runtime diagnostics options of the test action.
+func (a TestAction) Diagnostics() Diagnostics {
+	return newDiagnostics(a.EnableAddressSanitizer, a.EnableThreadSanitizer, a.EnableUBSanitizer, a.DisableMainThreadChecker)
+}
+
+// BuildableProductRunnable ...
+type BuildableProductRunnable struct {
+	RunnableDebuggingMode string `xml:"runnableDebuggingMode,attr"`
+	BuildableReference    BuildableReference
+}
+
+// MacroExpansion ...
+type MacroExpansion struct {
+	BuildableReference BuildableReference
+}
+
+// LaunchAction ...
+type LaunchAction struct {
+	BuildConfiguration       string `xml:"buildConfiguration,attr"`
+	BuildableProductRunnable BuildableProductRunnable
+	MacroExpansion           MacroExpansion
+
+	EnableAddressSanitizer   string `xml:"enableAddressSanitizer,attr"`
+	EnableThreadSanitizer    string `xml:"enableThreadSanitizer,attr"`
+	EnableUBSanitizer        string `xml:"enableUBSanitizer,attr"`
+	DisableMainThreadChecker string `xml:"disableMainThreadChecker,attr"`
+
+	PreActions  []ExecutionAction `xml:"PreActions>ExecutionAction"`
+	PostActions []ExecutionAction `xml:"PostActions>ExecutionAction"`
+}
+
+// Diagnostics returns the runtime diagnostics options of the launch action.
+func (a LaunchAction) Diagnostics() Diagnostics {
+	return newDiagnostics(a.EnableAddressSanitizer, a.EnableThreadSanitizer, a.EnableUBSanitizer, a.DisableMainThreadChecker)
 }
 
 // ArchiveAction ...
 type ArchiveAction struct {
 	BuildConfiguration string `xml:"buildConfiguration,attr"`
+
+	PreActions  []ExecutionAction `xml:"PreActions>ExecutionAction"`
+	PostActions []ExecutionAction `xml:"PostActions>ExecutionAction"`
 }
 
 // Scheme ...
@@ -69,6 +178,7 @@ type Scheme struct {
 	BuildAction   BuildAction
 	ArchiveAction ArchiveAction
 	TestAction    TestAction
+	LaunchAction  LaunchAction
 
 	Name string
 	Path string
@@ -92,6 +202,36 @@ func Open(pth string) (Scheme, error) {
 	return scheme, nil
 }
 
+// Diagnostics returns the runtime diagnostics options (sanitizers, main thread checker) used when running the scheme.
+func (s Scheme) Diagnostics() Diagnostics {
+	return s.LaunchAction.Diagnostics()
+}
+
+// RunnableBuildable returns the buildable launched by the scheme's run action:
+// the launch action's runnable, or the buildable used for macro expansion if the scheme has no runnable
+// (like app extension schemes asking for the app to run).
+func (s Scheme) RunnableBuildable() (BuildableReference, bool) {
+	if reference := s.LaunchAction.BuildableProductRunnable.BuildableReference; reference.BlueprintIdentifier != "" {
+		return reference, true
+	}
+	if reference := s.LaunchAction.MacroExpansion.BuildableReference; reference.BlueprintIdentifier != "" {
+		return reference, true
+	}
+	return BuildableReference{}, false
+}
+
+// BuildablesFor returns the buildables the scheme's build action builds for the given scheme action
+// (test, launch, profile, archive or analyze), like the test helper targets built only for testing.
+func (s Scheme) BuildablesFor(action string) []BuildableReference {
+	var references []BuildableReference
+	for _, entry := range s.BuildAction.BuildActionEntries {
+		if entry.BuildsFor(action) {
+			references = append(references, entry.BuildableReference)
+		}
+	}
+	return references
+}
+
 // AppBuildActionEntry ...
 func (s Scheme) AppBuildActionEntry() (BuildActionEntry, bool) {
 	var entry BuildActionEntry
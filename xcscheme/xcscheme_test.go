@@ -69,6 +69,124 @@ func TestAppTestActionEntry(t *testing.T) {
 	require.False(t, scheme.TestAction.Testables[1].BuildableReference.IsAppReference())
 }
 
+func TestScheme_RunnableBuildable(t *testing.T) {
+	var scheme Scheme
+	require.NoError(t, xml.Unmarshal([]byte(schemeContent), &scheme))
+
+	reference, ok := scheme.RunnableBuildable()
+	require.True(t, ok)
+	require.Equal(t, "BA3CBE7419F7A93800CED4D5", reference.BlueprintIdentifier)
+	require.Equal(t, "ios-simple-objc.app", reference.BuildableName)
+	require.Equal(t, "0", scheme.LaunchAction.BuildableProductRunnable.RunnableDebuggingMode)
+
+	var extensionScheme Scheme
+	require.NoError(t, xml.Unmarshal([]byte(extensionSchemeContent), &extensionScheme))
+
+	reference, ok = extensionScheme.RunnableBuildable()
+	require.True(t, ok)
+	require.Equal(t, "13E76E0D1F4AC90A0028096E", reference.BlueprintIdentifier)
+	require.Equal(t, "App.app", reference.BuildableName)
+
+	_, ok = Scheme{}.RunnableBuildable()
+	require.False(t, ok)
+}
+
+func TestScheme_BuildablesFor(t *testing.T) {
+	var scheme Scheme
+	require.NoError(t, xml.Unmarshal([]byte(schemeWithTestHelperContent), &scheme))
+
+	names := func(references []BuildableReference) []string {
+		var names []string
+		for _, reference := range references {
+			names = append(names, reference.BlueprintName)
+		}
+		return names
+	}
+
+	require.Equal(t, []string{"App", "TestHelpers"}, names(scheme.BuildablesFor(TestActionName)))
+	require.Equal(t, []string{"App"}, names(scheme.BuildablesFor(LaunchActionName)))
+	require.Equal(t, []string{"App"}, names(scheme.BuildablesFor(BuildActionName)))
+	require.Equal(t, []string{"App"}, names(scheme.BuildablesFor(ArchiveActionName)))
+	require.Equal(t, []string{"App", "TestHelpers"}, names(scheme.BuildablesFor(AnalyzeActionName)))
+	require.Empty(t, scheme.BuildablesFor("deploy"))
+
+	entry := scheme.BuildAction.BuildActionEntries[1]
+	require.True(t, entry.BuildsFor(TestActionName))
+	require.False(t, entry.BuildsFor(LaunchActionName))
+	require.False(t, entry.BuildsFor(ProfileActionName))
+}
+
+const schemeWithTestHelperContent = `<?xml version="1.0" encoding="UTF-8"?>
+<Scheme
+   LastUpgradeVersion = "1500"
+   version = "1.7">
+   <BuildAction
+      parallelizeBuildables = "YES"
+      buildImplicitDependencies = "YES">
+      <BuildActionEntries>
+         <BuildActionEntry
+            buildForTesting = "YES"
+            buildForRunning = "YES"
+            buildForProfiling = "YES"
+            buildForArchiving = "YES"
+            buildForAnalyzing = "YES">
+            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "13E76E0D1F4AC90A0028096E"
+               BuildableName = "App.app"
+               BlueprintName = "App"
+               ReferencedContainer = "container:App.xcodeproj">
+            </BuildableReference>
+         </BuildActionEntry>
+         <BuildActionEntry
+            buildForTesting = "YES"
+            buildForRunning = "NO"
+            buildForProfiling = "NO"
+            buildForArchiving = "NO"
+            buildForAnalyzing = "YES">
+            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "13E76E0E1F4AC90A0028096E"
+               BuildableName = "libTestHelpers.a"
+               BlueprintName = "TestHelpers"
+               ReferencedContainer = "container:App.xcodeproj">
+            </BuildableReference>
+         </BuildActionEntry>
+      </BuildActionEntries>
+   </BuildAction>
+</Scheme>
+`
+
+const extensionSchemeContent = `<?xml version="1.0" encoding="UTF-8"?>
+<Scheme
+   LastUpgradeVersion = "1200"
+   wasCreatedForAppExtension = "YES"
+   version = "2.0">
+   <LaunchAction
+      buildConfiguration = "Debug"
+      selectedDebuggerIdentifier = ""
+      selectedLauncherIdentifier = "Xcode.IDEFoundation.Launcher.PosixSpawn"
+      launchStyle = "0"
+      askForAppToLaunch = "Yes"
+      useCustomWorkingDirectory = "NO"
+      ignoresPersistentStateOnLaunch = "NO"
+      debugDocumentVersioning = "YES"
+      debugServiceExtension = "internal"
+      allowLocationSimulation = "YES"
+      launchAutomaticallySubstyle = "2">
+      <MacroExpansion>
+         <BuildableReference
+            BuildableIdentifier = "primary"
+            BlueprintIdentifier = "13E76E0D1F4AC90A0028096E"
+            BuildableName = "App.app"
+            BlueprintName = "App"
+            ReferencedContainer = "container:App.xcodeproj">
+         </BuildableReference>
+      </MacroExpansion>
+   </LaunchAction>
+</Scheme>
+`
+
 const schemeContent = `<?xml version="1.0" encoding="UTF-8"?>
 <Scheme
    LastUpgradeVersion = "0800"
@@ -195,3 +313,25 @@ const schemeContent = `<?xml version="1.0" encoding="UTF-8"?>
    </ArchiveAction>
 </Scheme>
 `
+
+func TestTestAction_DefaultTestPlan(t *testing.T) {
+	action := TestAction{TestPlans: []TestPlanReference{
+		{Reference: "container:Smoke.xctestplan"},
+		{Reference: "container:App.xctestplan", Default: "YES"},
+	}}
+	testPlan, ok := action.DefaultTestPlan()
+	require.True(t, ok)
+	require.Equal(t, "container:App.xctestplan", testPlan.Reference)
+
+	pth, err := testPlan.AbsPath("/work/App")
+	require.NoError(t, err)
+	require.Equal(t, "/work/App/App.xctestplan", pth)
+
+	action = TestAction{TestPlans: []TestPlanReference{{Reference: "container:Smoke.xctestplan"}}}
+	testPlan, ok = action.DefaultTestPlan()
+	require.True(t, ok)
+	require.Equal(t, "container:Smoke.xctestplan", testPlan.Reference)
+
+	_, ok = TestAction{}.DefaultTestPlan()
+	require.False(t, ok)
+}
@@ -0,0 +1,138 @@
+package xcstrings
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+)
+
+// TranslatedState is the state of a localization which is translated and reviewed.
+// Other states are like new, needs_review and stale.
+const TranslatedState = "translated"
+
+// StringUnit is a localized value of a string.
+type StringUnit struct {
+	State string `json:"state"`
+	Value string `json:"value"`
+}
+
+// Localization is the localization of a string in a language,
+// either a single StringUnit or Variations of it (like plural or device variations: plural -> one -> Localization).
+type Localization struct {
+	StringUnit *StringUnit                        `json:"stringUnit,omitempty"`
+	Variations map[string]map[string]Localization `json:"variations,omitempty"`
+}
+
+// IsTranslated reports whether the localization and all of its variations are translated.
+func (l Localization) IsTranslated() bool {
+	if l.StringUnit == nil && len(l.Variations) == 0 {
+		return false
+	}
+	if l.StringUnit != nil && l.StringUnit.State != TranslatedState {
+		return false
+	}
+	for _, variations := range l.Variations {
+		for _, variation := range variations {
+			if !variation.IsTranslated() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// String is an entry of the catalog, its localizations are keyed by language.
+type String struct {
+	Comment         string                  `json:"comment,omitempty"`
+	ExtractionState string                  `json:"extractionState,omitempty"`
+	ShouldTranslate *bool                   `json:"shouldTranslate,omitempty"`
+	Localizations   map[string]Localization `json:"localizations,omitempty"`
+}
+
+// NeedsTranslation reports whether the string is meant to be translated, strings are translated unless shouldTranslate is false.
+func (s String) NeedsTranslation() bool {
+	return s.ShouldTranslate == nil || *s.ShouldTranslate
+}
+
+// Catalog is a string catalog (.xcstrings file).
+type Catalog struct {
+	SourceLanguage string            `json:"sourceLanguage"`
+	Strings        map[string]String `json:"strings"`
+	Version        string            `json:"version"`
+
+	Path string `json:"-"`
+}
+
+// Open parses the string catalog at the given path.
+func Open(pth string) (Catalog, error) {
+	b, err := fileutil.ReadBytesFromFile(pth)
+	if err != nil {
+		return Catalog{}, err
+	}
+
+	var catalog Catalog
+	if err := json.Unmarshal(b, &catalog); err != nil {
+		return Catalog{}, fmt.Errorf("failed to unmarshal string catalog: %s, error: %s", pth, err)
+	}
+	catalog.Path = pth
+
+	return catalog, nil
+}
+
+// Keys returns the keys of the catalog's strings in alphabetical order.
+func (c Catalog) Keys() []string {
+	keys := make([]string, 0, len(c.Strings))
+	for key := range c.Strings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Languages returns the source language and the languages any of the strings is localized to, in alphabetical order.
+func (c Catalog) Languages() []string {
+	languageMap := map[string]bool{}
+	if c.SourceLanguage != "" {
+		languageMap[c.SourceLanguage] = true
+	}
+	for _, str := range c.Strings {
+		for language := range str.Localizations {
+			languageMap[language] = true
+		}
+	}
+
+	languages := make([]string, 0, len(languageMap))
+	for language := range languageMap {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// UntranslatedKeys returns the keys, in alphabetical order, of the strings which need translation (see String.NeedsTranslation)
+// but are not translated to the given language: their localization is missing or any of its values is not in TranslatedState.
+// A string without a localization in the source language is not untranslated in the source language, its key is used as the value.
+func (c Catalog) UntranslatedKeys(language string) []string {
+	var keys []string
+	for _, key := range c.Keys() {
+		str := c.Strings[key]
+		if !str.NeedsTranslation() {
+			continue
+		}
+
+		localization, ok := str.Localizations[language]
+		if !ok {
+			if language != c.SourceLanguage {
+				keys = append(keys, key)
+			}
+			continue
+		}
+
+		if !localization.IsTranslated() {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
@@ -0,0 +1,158 @@
+package xcstrings
+
+import (
+	"testing"
+
+	"github.com/bitrise-io/xcode-project/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpen(t *testing.T) {
+	pth := testhelper.CreateTmpFile(t, "Localizable.xcstrings", partiallyTranslatedCatalogContent)
+	catalog, err := Open(pth)
+	require.NoError(t, err)
+
+	require.Equal(t, pth, catalog.Path)
+	require.Equal(t, "en", catalog.SourceLanguage)
+	require.Equal(t, "1.0", catalog.Version)
+	require.Equal(t, []string{"%lld items", "Cancel", "Hello", "Version %@", "Welcome"}, catalog.Keys())
+
+	hello := catalog.Strings["Hello"]
+	require.Equal(t, "Greeting on the home screen", hello.Comment)
+	require.Equal(t, &StringUnit{State: "translated", Value: "Hallo"}, hello.Localizations["de"].StringUnit)
+
+	items := catalog.Strings["%lld items"]
+	require.Equal(t, "%lld Artikel", items.Localizations["de"].Variations["plural"]["other"].StringUnit.Value)
+
+	require.False(t, catalog.Strings["Version %@"].NeedsTranslation())
+
+	_, err = Open(testhelper.CreateTmpFile(t, "Invalid.xcstrings", "{"))
+	require.Error(t, err)
+}
+
+func TestCatalog_Languages(t *testing.T) {
+	pth := testhelper.CreateTmpFile(t, "Localizable.xcstrings", partiallyTranslatedCatalogContent)
+	catalog, err := Open(pth)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"de", "en", "fr"}, catalog.Languages())
+}
+
+func TestCatalog_UntranslatedKeys(t *testing.T) {
+	pth := testhelper.CreateTmpFile(t, "Localizable.xcstrings", partiallyTranslatedCatalogContent)
+	catalog, err := Open(pth)
+	require.NoError(t, err)
+
+	tests := []struct {
+		language string
+		want     []string
+	}{
+		{language: "en", want: nil},
+		{language: "de", want: []string{"Welcome"}},
+		{language: "fr", want: []string{"%lld items", "Cancel", "Welcome"}},
+		{language: "ja", want: []string{"%lld items", "Cancel", "Hello", "Welcome"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.language, func(t *testing.T) {
+			require.Equal(t, tt.want, catalog.UntranslatedKeys(tt.language))
+		})
+	}
+}
+
+// partiallyTranslatedCatalogContent is an English string catalog, partially translated to German and French:
+// the German Welcome translation needs review, the French plural variations are incomplete
+// and the Version %@ string is not meant to be translated.
+const partiallyTranslatedCatalogContent = `{
+  "sourceLanguage" : "en",
+  "strings" : {
+    "%lld items" : {
+      "localizations" : {
+        "de" : {
+          "variations" : {
+            "plural" : {
+              "one" : {
+                "stringUnit" : {
+                  "state" : "translated",
+                  "value" : "%lld Artikel"
+                }
+              },
+              "other" : {
+                "stringUnit" : {
+                  "state" : "translated",
+                  "value" : "%lld Artikel"
+                }
+              }
+            }
+          }
+        },
+        "fr" : {
+          "variations" : {
+            "plural" : {
+              "one" : {
+                "stringUnit" : {
+                  "state" : "translated",
+                  "value" : "%lld article"
+                }
+              },
+              "other" : {
+                "stringUnit" : {
+                  "state" : "new",
+                  "value" : ""
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "Cancel" : {
+      "localizations" : {
+        "de" : {
+          "stringUnit" : {
+            "state" : "translated",
+            "value" : "Abbrechen"
+          }
+        }
+      }
+    },
+    "Hello" : {
+      "comment" : "Greeting on the home screen",
+      "localizations" : {
+        "de" : {
+          "stringUnit" : {
+            "state" : "translated",
+            "value" : "Hallo"
+          }
+        },
+        "fr" : {
+          "stringUnit" : {
+            "state" : "translated",
+            "value" : "Bonjour"
+          }
+        }
+      }
+    },
+    "Version %@" : {
+      "shouldTranslate" : false
+    },
+    "Welcome" : {
+      "extractionState" : "manual",
+      "localizations" : {
+        "de" : {
+          "stringUnit" : {
+            "state" : "needs_review",
+            "value" : "Willkommen"
+          }
+        },
+        "en" : {
+          "stringUnit" : {
+            "state" : "translated",
+            "value" : "Welcome!"
+          }
+        }
+      }
+    }
+  },
+  "version" : "1.0"
+}
+`
@@ -0,0 +1,46 @@
+package xcworkspace
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/bitrise-io/go-utils/pathutil"
+	"github.com/bitrise-io/xcode-project/serialized"
+	"github.com/bitrise-io/xcode-project/xcodeproj"
+)
+
+// LegacyBuildSystemType is the BuildSystemType of workspaces pinned to the legacy build system.
+const LegacyBuildSystemType = "Original"
+
+// BuildSystemType returns the build system the workspace is pinned to (BuildSystemType in the shared workspace settings:
+// xcshareddata/WorkspaceSettings.xcsettings), like LegacyBuildSystemType.
+// An empty string is returned if the workspace does not pin the build system, Xcode's default build system is used then.
+func (w Workspace) BuildSystemType() (string, error) {
+	settingsPth := filepath.Join(w.Path, "xcshareddata", "WorkspaceSettings.xcsettings")
+	if exist, err := pathutil.IsPathExists(settingsPth); err != nil {
+		return "", fmt.Errorf("failed to check if workspace settings exist at: %s, error: %s", settingsPth, err)
+	} else if !exist {
+		return "", nil
+	}
+
+	settings, _, err := xcodeproj.ReadPlistFile(settingsPth)
+	if err != nil {
+		return "", fmt.Errorf("failed to read workspace settings: %s, error: %s", settingsPth, err)
+	}
+
+	buildSystemType, err := settings.String("BuildSystemType")
+	if err != nil {
+		if serialized.IsKeyNotFoundError(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return buildSystemType, nil
+}
+
+// ResolvedSwiftPackages returns the Swift packages pinned in the workspace's xcshareddata/swiftpm/Package.resolved file,
+// where Xcode keeps it for the projects opened through the workspace.
+// If the workspace has no Package.resolved file, no packages are returned.
+func (w Workspace) ResolvedSwiftPackages() ([]xcodeproj.ResolvedPackage, error) {
+	return xcodeproj.ReadWorkspacePackageResolved(w.Path)
+}
@@ -0,0 +1,132 @@
+package xcworkspace
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+	"github.com/bitrise-io/go-utils/pathutil"
+	"github.com/bitrise-io/xcode-project/xcodeproj"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspaceBuildSystemType(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings string
+		want     string
+	}{
+		{
+			name:     "legacy build system",
+			settings: legacyBuildSystemWorkspaceSettingsContent,
+			want:     LegacyBuildSystemType,
+		},
+		{
+			name:     "build system not pinned",
+			settings: workspaceSettingsContent,
+			want:     "",
+		},
+		{
+			name: "no workspace settings",
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir, err := pathutil.NormalizedOSTempDirPath("__xcworkspace__")
+			require.NoError(t, err)
+
+			files := map[string]string{
+				"App.xcworkspace/contents.xcworkspacedata": transitiveWorkspaceContentsContent,
+			}
+			if tt.settings != "" {
+				files["App.xcworkspace/xcshareddata/WorkspaceSettings.xcsettings"] = tt.settings
+			}
+			for pth, content := range files {
+				pth = filepath.Join(tmpDir, pth)
+				require.NoError(t, os.MkdirAll(filepath.Dir(pth), 0755))
+				require.NoError(t, fileutil.WriteStringToFile(pth, content))
+			}
+
+			workspace, err := Open(filepath.Join(tmpDir, "App.xcworkspace"))
+			require.NoError(t, err)
+
+			got, err := workspace.BuildSystemType()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWorkspace_ResolvedSwiftPackages(t *testing.T) {
+	tmpDir, err := pathutil.NormalizedOSTempDirPath("__xcworkspace__")
+	require.NoError(t, err)
+
+	for pth, content := range map[string]string{
+		"App.xcworkspace/contents.xcworkspacedata":              transitiveWorkspaceContentsContent,
+		"App.xcworkspace/xcshareddata/swiftpm/Package.resolved": packageResolvedContent,
+		"Empty.xcworkspace/contents.xcworkspacedata":            transitiveWorkspaceContentsContent,
+	} {
+		pth = filepath.Join(tmpDir, pth)
+		require.NoError(t, os.MkdirAll(filepath.Dir(pth), 0755))
+		require.NoError(t, fileutil.WriteStringToFile(pth, content))
+	}
+
+	workspace, err := Open(filepath.Join(tmpDir, "App.xcworkspace"))
+	require.NoError(t, err)
+
+	packages, err := workspace.ResolvedSwiftPackages()
+	require.NoError(t, err)
+	require.Equal(t, []xcodeproj.ResolvedPackage{{
+		Identity: "alamofire",
+		URL:      "https://github.com/Alamofire/Alamofire.git",
+		Version:  "5.8.1",
+		Revision: "3dc6a42c7727c49bf26508e29b0a0b35f9c7e1ad",
+	}}, packages)
+
+	workspace, err = Open(filepath.Join(tmpDir, "Empty.xcworkspace"))
+	require.NoError(t, err)
+
+	packages, err = workspace.ResolvedSwiftPackages()
+	require.NoError(t, err)
+	require.Nil(t, packages)
+}
+
+const packageResolvedContent = `{
+  "pins" : [
+    {
+      "identity" : "alamofire",
+      "kind" : "remoteSourceControl",
+      "location" : "https://github.com/Alamofire/Alamofire.git",
+      "state" : {
+        "revision" : "3dc6a42c7727c49bf26508e29b0a0b35f9c7e1ad",
+        "version" : "5.8.1"
+      }
+    }
+  ],
+  "version" : 2
+}
+`
+
+const legacyBuildSystemWorkspaceSettingsContent = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>BuildSystemType</key>
+	<string>Original</string>
+	<key>PreviewsEnabled</key>
+	<false/>
+</dict>
+</plist>
+`
+
+const workspaceSettingsContent = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>PreviewsEnabled</key>
+	<false/>
+</dict>
+</plist>
+`
@@ -89,6 +89,30 @@ func (w Workspace) Schemes() (map[string][]xcscheme.Scheme, error) {
 	return schemesByContainer, nil
 }
 
+// HasSharedSchemes reports whether the workspace or any of its existing projects has a shared scheme.
+func (w Workspace) HasSharedSchemes() (bool, error) {
+	if hasSharedSchemes, err := xcscheme.HasSharedSchemesIn(w.Path); err != nil {
+		return false, err
+	} else if hasSharedSchemes {
+		return true, nil
+	}
+
+	projectLocations, err := w.ProjectFileLocations()
+	if err != nil {
+		return false, err
+	}
+
+	for _, projectLocation := range projectLocations {
+		if hasSharedSchemes, err := xcscheme.HasSharedSchemesIn(projectLocation); err != nil {
+			return false, err
+		} else if hasSharedSchemes {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 // FileLocations ...
 func (w Workspace) FileLocations() ([]string, error) {
 	var fileLocations []string
@@ -129,6 +153,47 @@ func (w Workspace) ProjectFileLocations() ([]string, error) {
 	return projectLocations, nil
 }
 
+// AllProjects returns the projects referenced by the workspace, including the projects referenced transitively
+// as subprojects or by cross-project dependencies. Each project is returned once, missing projects are skipped.
+func (w Workspace) AllProjects() ([]xcodeproj.XcodeProj, error) {
+	projectLocations, err := w.ProjectFileLocations()
+	if err != nil {
+		return nil, err
+	}
+
+	var projects []xcodeproj.XcodeProj
+	visited := map[string]bool{}
+	for len(projectLocations) > 0 {
+		projectLocation := projectLocations[0]
+		projectLocations = projectLocations[1:]
+
+		if visited[projectLocation] {
+			continue
+		}
+		visited[projectLocation] = true
+
+		if exist, err := pathutil.IsPathExists(projectLocation); err != nil {
+			return nil, fmt.Errorf("failed to check if project exist at: %s, error: %s", projectLocation, err)
+		} else if !exist {
+			continue
+		}
+
+		project, err := xcodeproj.Open(projectLocation)
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, project)
+
+		referencedProjectLocations, err := project.ReferencedProjectPaths()
+		if err != nil {
+			return nil, err
+		}
+		projectLocations = append(projectLocations, referencedProjectLocations...)
+	}
+
+	return projects, nil
+}
+
 // Open ...
 func Open(pth string) (Workspace, error) {
 	contentsPth := filepath.Join(pth, "contents.xcworkspacedata")
@@ -1,9 +1,13 @@
 package xcworkspace
 
 import (
+	"fmt"
+	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/bitrise-io/go-utils/fileutil"
+	"github.com/bitrise-io/go-utils/pathutil"
 	"github.com/bitrise-io/xcode-project/testhelper"
 	"github.com/bitrise-io/xcode-project/xcscheme"
 	"github.com/stretchr/testify/require"
@@ -145,6 +149,94 @@ func TestOpen(t *testing.T) {
 	require.Equal(t, "group:Group", workspace.Groups[0].Location)
 }
 
+func TestWorkspaceAllProjects(t *testing.T) {
+	tmpDir, err := pathutil.NormalizedOSTempDirPath("__xcworkspace__")
+	require.NoError(t, err)
+
+	for pth, content := range map[string]string{
+		"App.xcworkspace/contents.xcworkspacedata": transitiveWorkspaceContentsContent,
+		"App/App.xcodeproj/project.pbxproj":        fmt.Sprintf(minimalProjectContent, subprojectReferenceContent),
+		"Lib/Lib.xcodeproj/project.pbxproj":        fmt.Sprintf(minimalProjectContent, crossProjectReferenceContent),
+		"Core/Core.xcodeproj/project.pbxproj":      fmt.Sprintf(minimalProjectContent, ""),
+	} {
+		pth = filepath.Join(tmpDir, pth)
+		require.NoError(t, os.MkdirAll(filepath.Dir(pth), 0755))
+		require.NoError(t, fileutil.WriteStringToFile(pth, content))
+	}
+
+	workspace, err := Open(filepath.Join(tmpDir, "App.xcworkspace"))
+	require.NoError(t, err)
+
+	projects, err := workspace.AllProjects()
+	require.NoError(t, err)
+
+	var projectPaths []string
+	for _, project := range projects {
+		projectPaths = append(projectPaths, project.Path)
+	}
+	require.Equal(t, []string{
+		filepath.Join(tmpDir, "App/App.xcodeproj"),
+		filepath.Join(tmpDir, "Lib/Lib.xcodeproj"),
+		filepath.Join(tmpDir, "Core/Core.xcodeproj"),
+	}, projectPaths)
+}
+
+func TestWorkspaceHasSharedSchemes(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  bool
+	}{
+		{
+			name: "no shared schemes",
+			files: map[string]string{
+				"App.xcworkspace/xcuserdata/user.xcuserdatad/xcschemes/App.xcscheme": "",
+			},
+			want: false,
+		},
+		{
+			name: "workspace shared scheme",
+			files: map[string]string{
+				"App.xcworkspace/xcshareddata/xcschemes/App.xcscheme": "",
+			},
+			want: true,
+		},
+		{
+			name: "project shared scheme",
+			files: map[string]string{
+				"App/App.xcodeproj/xcshareddata/xcschemes/App.xcscheme": "",
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir, err := pathutil.NormalizedOSTempDirPath("__xcworkspace__")
+			require.NoError(t, err)
+
+			files := map[string]string{
+				"App.xcworkspace/contents.xcworkspacedata": transitiveWorkspaceContentsContent,
+				"App/App.xcodeproj/project.pbxproj":        fmt.Sprintf(minimalProjectContent, ""),
+			}
+			for pth, content := range tt.files {
+				files[pth] = content
+			}
+			for pth, content := range files {
+				pth = filepath.Join(tmpDir, pth)
+				require.NoError(t, os.MkdirAll(filepath.Dir(pth), 0755))
+				require.NoError(t, fileutil.WriteStringToFile(pth, content))
+			}
+
+			workspace, err := Open(filepath.Join(tmpDir, "App.xcworkspace"))
+			require.NoError(t, err)
+
+			got, err := workspace.HasSharedSchemes()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestIsWorkspace(t *testing.T) {
 	require.True(t, IsWorkspace("./BitriseSample.xcworkspace"))
 	require.False(t, IsWorkspace("./BitriseSample.xcodeproj"))
@@ -205,3 +297,88 @@ const workspaceContentsContent = `<?xml version="1.0" encoding="UTF-8"?>
    </FileRef>
 </Workspace>
 `
+
+const transitiveWorkspaceContentsContent = `<?xml version="1.0" encoding="UTF-8"?>
+<Workspace
+   version = "1.0">
+   <FileRef
+      location = "group:App/App.xcodeproj">
+   </FileRef>
+   <FileRef
+      location = "group:Missing/Missing.xcodeproj">
+   </FileRef>
+   <FileRef
+      location = "group:Lib/Lib.xcodeproj">
+   </FileRef>
+</Workspace>
+`
+
+// minimalProjectContent is a project.pbxproj without targets, its format verb is replaced with additional objects.
+const minimalProjectContent = `// !$*UTF8*$!
+{
+	archiveVersion = 1;
+	classes = {
+	};
+	objectVersion = 56;
+	objects = {
+%s
+		A10000000000000000000001 = {
+			isa = PBXGroup;
+			children = (
+				A10000000000000000000010 /* Referenced.xcodeproj */,
+			);
+			sourceTree = "<group>";
+		};
+		A10000000000000000000002 /* Project object */ = {
+			isa = PBXProject;
+			attributes = {
+				LastUpgradeCheck = 1500;
+			};
+			buildConfigurationList = A10000000000000000000003 /* Build configuration list for PBXProject */;
+			mainGroup = A10000000000000000000001;
+			projectDirPath = "";
+			projectRoot = "";
+			targets = (
+			);
+		};
+		A10000000000000000000003 /* Build configuration list for PBXProject */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				A10000000000000000000004 /* Debug */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Debug;
+		};
+		A10000000000000000000004 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				SDKROOT = iphoneos;
+			};
+			name = Debug;
+		};
+	};
+	rootObject = A10000000000000000000002 /* Project object */;
+}
+`
+
+const subprojectReferenceContent = `
+		A10000000000000000000010 /* Lib.xcodeproj */ = {isa = PBXFileReference; lastKnownFileType = "wrapper.pb-project"; path = ../Lib/Lib.xcodeproj; sourceTree = "<group>"; };
+		A10000000000000000000011 /* PBXContainerItemProxy */ = {
+			isa = PBXContainerItemProxy;
+			containerPortal = A10000000000000000000010 /* Lib.xcodeproj */;
+			proxyType = 2;
+			remoteGlobalIDString = B10000000000000000000001;
+			remoteInfo = Lib;
+		};
+`
+
+const crossProjectReferenceContent = `
+		A10000000000000000000010 /* Core.xcodeproj */ = {isa = PBXFileReference; lastKnownFileType = "wrapper.pb-project"; path = ../Core/Core.xcodeproj; sourceTree = SOURCE_ROOT; };
+		A10000000000000000000011 /* PBXContainerItemProxy */ = {
+			isa = PBXContainerItemProxy;
+			containerPortal = A10000000000000000000010 /* Core.xcodeproj */;
+			proxyType = 1;
+			remoteGlobalIDString = C10000000000000000000001;
+			remoteInfo = Core;
+		};
+`